@@ -0,0 +1,290 @@
+package bittrex
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+//AddressValidator checks the format of a withdrawal address for one currency.
+type AddressValidator func(address string) error
+
+/*
+addressValidators formats known for common coins.  Currencies missing from here are not format checked; add them
+with WithdrawalPolicyConfig.AddressValidators.
+*/
+var addressValidators = map[string]AddressValidator{
+	"BTC":  bitcoinStyleValidator([]byte{0x00, 0x05}, "bc"),
+	"LTC":  bitcoinStyleValidator([]byte{0x30, 0x32, 0x05}, "ltc"),
+	"DOGE": bitcoinStyleValidator([]byte{0x1e, 0x16}, ""),
+	"DASH": bitcoinStyleValidator([]byte{0x4c, 0x10}, ""),
+	"ETH":  validateEthereumAddress,
+	"ETC":  validateEthereumAddress,
+}
+
+//ValidateAddress check address is well formed for currency, checksum included.  Unknown currencies always pass.
+func ValidateAddress(currency string, address string) error {
+	validator, ok := addressValidators[strings.ToUpper(currency)]
+	if !ok {
+		return nil
+	}
+
+	return validator(address)
+}
+
+//bitcoinStyleValidator base58check addresses with one of versions, or bech32 segwit addresses with hrp.
+func bitcoinStyleValidator(versions []byte, hrp string) AddressValidator {
+	return func(address string) error {
+		if hrp != "" && strings.HasPrefix(strings.ToLower(address), hrp+"1") {
+			return validateBech32(address, hrp)
+		}
+
+		return validateBase58Check(address, versions)
+	}
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func validateBase58Check(address string, versions []byte) error {
+	decoded, decodeErr := base58Decode(address)
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	if len(decoded) != 25 {
+		return fmt.Errorf("address %s decodes to %d bytes, expected 25", address, len(decoded))
+	}
+
+	first := sha256.Sum256(decoded[:21])
+	second := sha256.Sum256(first[:])
+
+	if string(second[:4]) != string(decoded[21:]) {
+		return fmt.Errorf("address %s has a bad checksum", address)
+	}
+
+	for _, version := range versions {
+		if decoded[0] == version {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("address %s has version byte 0x%02x, which is not valid for this currency", address, decoded[0])
+}
+
+func base58Decode(value string) ([]byte, error) {
+	if value == "" {
+		return nil, fmt.Errorf("address is empty")
+	}
+
+	number := new(big.Int)
+	radix := big.NewInt(58)
+
+	for _, r := range value {
+		digit := strings.IndexRune(base58Alphabet, r)
+		if digit < 0 {
+			return nil, fmt.Errorf("address %s contains %q, which is not base58", value, r)
+		}
+
+		number.Mul(number, radix)
+		number.Add(number, big.NewInt(int64(digit)))
+	}
+
+	leadingZeros := 0
+	for leadingZeros < len(value) && value[leadingZeros] == '1' {
+		leadingZeros++
+	}
+
+	return append(make([]byte, leadingZeros), number.Bytes()...), nil
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+//checksum constants for bech32 (witness version 0) and bech32m (version 1 and up).
+const (
+	bech32Const  uint32 = 1
+	bech32mConst uint32 = 0x2bc830a3
+)
+
+func validateBech32(address string, hrp string) error {
+	if len(address) < 14 || len(address) > 90 {
+		return fmt.Errorf("address %s has an invalid length for bech32", address)
+	}
+
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return fmt.Errorf("address %s mixes upper and lower case", address)
+	}
+
+	lower := strings.ToLower(address)
+	separator := strings.LastIndexByte(lower, '1')
+
+	if lower[:separator] != hrp || len(lower)-separator-1 < 7 {
+		return fmt.Errorf("address %s is not a %s bech32 address", address, hrp)
+	}
+
+	values := make([]byte, 0, len(hrp)*2+1+len(lower)-separator-1)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := 0; i < len(hrp); i++ {
+		values = append(values, hrp[i]&31)
+	}
+
+	data := make([]byte, 0, len(lower)-separator-1)
+	for _, r := range lower[separator+1:] {
+		digit := strings.IndexRune(bech32Charset, r)
+		if digit < 0 {
+			return fmt.Errorf("address %s contains %q, which is not bech32", address, r)
+		}
+		data = append(data, byte(digit))
+	}
+
+	expected := bech32Const
+	if data[0] > 16 {
+		return fmt.Errorf("address %s has an invalid witness version", address)
+	} else if data[0] > 0 {
+		expected = bech32mConst
+	}
+
+	if bech32Polymod(append(values, data...)) != expected {
+		return fmt.Errorf("address %s has a bad checksum", address)
+	}
+
+	return nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+
+		for i := uint(0); i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator[i]
+			}
+		}
+	}
+
+	return checksum
+}
+
+//validateEthereumAddress 0x and 40 hex digits.  Mixed case addresses must carry a valid EIP-55 checksum.
+func validateEthereumAddress(address string) error {
+	if len(address) != 42 || !strings.HasPrefix(address, "0x") {
+		return fmt.Errorf("address %s is not 0x followed by 40 hex digits", address)
+	}
+
+	digits := address[2:]
+	if _, hexErr := hex.DecodeString(digits); hexErr != nil {
+		return fmt.Errorf("address %s is not 0x followed by 40 hex digits", address)
+	}
+
+	if strings.ToLower(digits) == digits || strings.ToUpper(digits) == digits {
+		return nil
+	}
+
+	hash := keccak256([]byte(strings.ToLower(digits)))
+
+	for i, r := range digits {
+		if r >= '0' && r <= '9' {
+			continue
+		}
+
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+
+		//a letter is upper case exactly when its nibble of the hash is 8 or more.
+		if (nibble >= 8) != (r >= 'A' && r <= 'F') {
+			return fmt.Errorf("address %s has a bad EIP-55 checksum", address)
+		}
+	}
+
+	return nil
+}
+
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+//keccakRotations rotation offsets, indexed x + 5y.
+var keccakRotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+//keccak256 the original Keccak padding used by ethereum, not NIST SHA3-256.
+func keccak256(data []byte) [32]byte {
+	const rate = 136
+
+	var state [25]uint64
+
+	padded := make([]byte, len(data), len(data)+rate)
+	copy(padded, data)
+	padded = append(padded, 0x01)
+	for len(padded)%rate != 0 {
+		padded = append(padded, 0)
+	}
+	padded[len(padded)-1] |= 0x80
+
+	for block := 0; block < len(padded); block += rate {
+		for i := 0; i < rate/8; i++ {
+			state[i] ^= binary.LittleEndian.Uint64(padded[block+i*8:])
+		}
+		keccakF(&state)
+	}
+
+	var out [32]byte
+	for i := 0; i < 4; i++ {
+		binary.LittleEndian.PutUint64(out[i*8:], state[i])
+	}
+
+	return out
+}
+
+func keccakF(a *[25]uint64) {
+	for round := 0; round < 24; round++ {
+		var c [5]uint64
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+
+		for x := 0; x < 5; x++ {
+			d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				a[y+x] ^= d
+			}
+		}
+
+		var b [25]uint64
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[x+5*y], keccakRotations[x+5*y])
+			}
+		}
+
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				a[x+5*y] = b[x+5*y] ^ (^b[(x+1)%5+5*y] & b[(x+2)%5+5*y])
+			}
+		}
+
+		a[0] ^= keccakRoundConstants[round]
+	}
+}
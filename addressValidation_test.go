@@ -0,0 +1,97 @@
+package bittrex
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestKeccak256KnownAnswers(t *testing.T) {
+	vectors := map[string]string{
+		"":    "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		"abc": "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+	}
+
+	for input, expected := range vectors {
+		hash := keccak256([]byte(input))
+		if got := hex.EncodeToString(hash[:]); got != expected {
+			t.Errorf("keccak256(%q) = %s, expected %s", input, got, expected)
+		}
+	}
+}
+
+//TestEIP55Addresses the checksummed addresses from EIP-55.
+func TestEIP55Addresses(t *testing.T) {
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		"0x52908400098527886e0f7030069857d2e4169ee7",
+		"0x8617E340B3D01FA5F11F306F4090FD50E238070D",
+	} {
+		if err := ValidateAddress("ETH", address); err != nil {
+			t.Errorf("%s: %s", address, err.Error())
+		}
+	}
+
+	if err := ValidateAddress("ETH", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"); err == nil {
+		t.Error("expected a bad EIP-55 checksum to be rejected")
+	}
+}
+
+//TestBech32Addresses the mainnet vectors from BIP-173 (bech32) and BIP-350 (bech32m).
+func TestBech32Addresses(t *testing.T) {
+	for _, address := range []string{
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+		"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3",
+		"bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y",
+		"BC1SW50QGDZ25J",
+		"bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs",
+	} {
+		if err := ValidateAddress("BTC", address); err != nil {
+			t.Errorf("%s: %s", address, err.Error())
+		}
+	}
+
+	for address, reason := range map[string]string{
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5":                     "bad checksum",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh":                     "bech32m checksum on witness version 0",
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd": "bech32 checksum on witness version 1",
+		"BC130XLXVLHEMJA6C4DQV22UAPCTQUPFHLXM9H8Z3K2E72Q4K9HCZ7VQ7ZWS8R": "witness version 17",
+		"bc1qW508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4":                     "mixed case",
+		"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx":                     "testnet prefix",
+	} {
+		if err := ValidateAddress("BTC", address); err == nil {
+			t.Errorf("expected %s to be rejected: %s", address, reason)
+		}
+	}
+}
+
+func TestBase58CheckAddresses(t *testing.T) {
+	for _, address := range []string{
+		"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+	} {
+		if err := ValidateAddress("BTC", address); err != nil {
+			t.Errorf("%s: %s", address, err.Error())
+		}
+	}
+
+	if err := ValidateAddress("BTC", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3"); err == nil {
+		t.Error("expected a bad base58check checksum to be rejected")
+	}
+
+	if err := ValidateAddress("BTC", "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNV0"); err == nil {
+		t.Error("expected a character outside the base58 alphabet to be rejected")
+	}
+
+	//a well formed litecoin address, whose version byte bitcoin doesn't use.
+	if err := ValidateAddress("LTC", "LKDyUEtTR1HXamkiEphisSiBJu6o3ZPE34"); err != nil {
+		t.Errorf("LKDyUEtTR1HXamkiEphisSiBJu6o3ZPE34: %s", err.Error())
+	}
+
+	if err := ValidateAddress("BTC", "LKDyUEtTR1HXamkiEphisSiBJu6o3ZPE34"); err == nil {
+		t.Error("expected a litecoin version byte to be rejected for BTC")
+	}
+}
@@ -0,0 +1,45 @@
+package bittrex
+
+import "github.com/technicalviking/bittrex2/socketPayloads"
+
+/*
+API the public, account, market and v2 trade calls, and the socket subscriptions, of a Client.  Code written against
+API works the same with a Client talking to Bittrex directly and with the rpc package's Client talking to a gRPC
+server that wraps one.
+*/
+type API interface {
+	PublicGetMarkets() ([]MarketDescription, error)
+	PublicGetCurrencies() ([]Currency, error)
+	PublicGetTicker(market string) (Ticker, error)
+	PublicGetMarketSummaries() ([]MarketSummary, error)
+	PublicGetMarketSummary(market string) (MarketSummary, error)
+	PublicGetOrderBook(market string, orderType string) (OrderBook, error)
+	PublicGetMarketHistory(market string) ([]Trade, error)
+
+	AccountGetBalances() ([]AccountBalance, error)
+	AccountGetBalance(currency string) (AccountBalance, error)
+	AccountGetDepositAddress(currency string) (WalletAddress, error)
+	AccountWithdraw(currency string, quantity decimal, address string, paymentID string) (TransactionID, error)
+	AccountGetOrder(orderID string) (AccountOrderDescription, error)
+	AccountGetOrderHistory(market string) ([]AccountOrderHistoryDescription, error)
+	AccountGetWithdrawalHistory(currency string) ([]TransactionHistoryDescription, error)
+	AccountGetDepositHistory(currency string) ([]TransactionHistoryDescription, error)
+
+	MarketBuyLimit(market string, quantity decimal, rate decimal) (TransactionID, error)
+	MarketSellLimit(market string, quantity decimal, rate decimal) (TransactionID, error)
+	MarketCancel(uuid string) (bool, error)
+	MarketGetOpenOrders(market string) ([]OrderDescription, error)
+
+	PubMarketGetTicks(market string, interval string) ([]Candle, error)
+	PubMarketGetLatestTick(market string, interval string) (Candle, error)
+	PlaceOrder(request OrderRequest) (PlacedOrder, error)
+	KeyMarketTradeBuy(market string, quantity float64, rate float64, timeInEffect string, conditionType string, conditionTarget float64) (bool, error)
+	KeyMarketTradeSell(market string, quantity float64, rate float64, timeInEffect string, conditionType string, conditionTarget float64) (bool, error)
+
+	SubscribeToMarketSummary(market string) (chan socketPayloads.Summary, error)
+	SubscribeToExchange(market string) (chan socketPayloads.ExchangeDelta, error)
+	SubscribeToOrderChanges() chan socketPayloads.OrderResponse
+	SubscribeToBalanceChanges() chan socketPayloads.BalanceDelta
+}
+
+var _ API = (*Client)(nil)
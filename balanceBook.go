@@ -0,0 +1,429 @@
+package bittrex
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//BalanceChangeType what caused a BalanceChange.
+type BalanceChangeType int
+
+//Balance Change Types
+const (
+	BalanceDeltaApplied BalanceChangeType = iota //a balance delta from the socket
+	BalanceDrift                                 //a REST snapshot disagreed with the book, and replaced it
+)
+
+/*
+BalanceChange a currency's balance changed.  Previous is zero for a currency seen for the first time, and Current is
+zero for a currency a snapshot no longer lists.
+*/
+type BalanceChange struct {
+	Type     BalanceChangeType
+	Currency string
+	Previous AccountBalance
+	Current  AccountBalance
+	Nonce    int
+	Time     time.Time
+}
+
+type bookedBalance struct {
+	AccountBalance
+	updated time.Time
+	nonce   int //of the delta the balance came from, or the last nonce seen when its snapshot was taken
+}
+
+/*
+BalanceBook account balances seeded from AccountGetBalances and kept current from the balance deltas (uB).
+Each delta carries the whole balance of one currency, so the socket delivering them out of order is handled per
+currency: a delta is applied if its Nonce is newer than the one the currency's balance came from, and ignored
+otherwise.  A gap in the nonce sequence that doesn't fill within reorderWindow, or the reconcile interval, triggers a
+fresh REST snapshot; balances that disagree with it are corrected and reported as BalanceDrift, and currencies it no
+longer lists are removed.
+*/
+type BalanceBook struct {
+	client *Client
+
+	mutex     sync.RWMutex
+	balances  map[string]*bookedBalance
+	nonce     int               //highest applied
+	nonceAt   time.Time         //when nonce was applied
+	sequence  int               //bumped when the socket starts a new nonce sequence
+	missing   map[int]time.Time //nonces skipped over, and when the gap was seen
+	refreshed time.Time
+
+	subscriberMutex sync.RWMutex
+	subscribers     []chan BalanceChange
+
+	errChan chan error
+	poke    chan struct{}
+	quit    chan struct{}
+
+	removeListener func()
+	closeOnce      sync.Once
+}
+
+//NewBalanceBook load the balances and follow the deltas.  interval of zero or less disables periodic reconciling.
+func NewBalanceBook(c *Client, interval time.Duration) (*BalanceBook, error) {
+	b := &BalanceBook{
+		client:   c,
+		balances: make(map[string]*bookedBalance),
+		missing:  make(map[int]time.Time),
+		errChan:  make(chan error, 5),
+		poke:     make(chan struct{}, 1),
+		quit:     make(chan struct{}),
+	}
+
+	//listening before the snapshot, so nothing between the two is lost.
+	b.removeListener = c.addBalanceListener(b.apply)
+
+	if reconcileErr := b.Reconcile(); reconcileErr != nil {
+		b.removeListener()
+		return nil, reconcileErr
+	}
+
+	go b.run(interval)
+
+	return b, nil
+}
+
+//Subscribe receive a BalanceChange for every change.  Buffered; changes are dropped for a subscriber that falls behind.
+func (b *BalanceBook) Subscribe() chan BalanceChange {
+	newChan := make(chan BalanceChange, 100)
+
+	b.subscriberMutex.Lock()
+	b.subscribers = append(b.subscribers, newChan)
+	b.subscriberMutex.Unlock()
+
+	return newChan
+}
+
+//Unsubscribe stop sending changes to a channel returned by Subscribe, and close it.
+func (b *BalanceBook) Unsubscribe(ch chan BalanceChange) {
+	b.subscriberMutex.Lock()
+	defer b.subscriberMutex.Unlock()
+
+	for i, subscriber := range b.subscribers {
+		if subscriber == ch {
+			b.subscribers = append(b.subscribers[:i], b.subscribers[i+1:]...)
+			close(ch)
+			return
+		}
+	}
+}
+
+//Errors failed reconciles.  Buffered; errors are dropped if it fills.
+func (b *BalanceBook) Errors() chan error {
+	return b.errChan
+}
+
+//Close stop following the deltas and reconciling.
+func (b *BalanceBook) Close() {
+	b.closeOnce.Do(func() {
+		close(b.quit)
+		b.removeListener()
+	})
+}
+
+//Balance the full balance record for currency.  false if the currency has never been seen.
+func (b *BalanceBook) Balance(currency string) (AccountBalance, bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	booked, ok := b.balances[strings.ToUpper(currency)]
+	if !ok {
+		return AccountBalance{}, false
+	}
+
+	return booked.AccountBalance, true
+}
+
+//Total balance of currency, including amounts reserved by open orders.
+func (b *BalanceBook) Total(currency string) decimal {
+	balance, _ := b.Balance(currency)
+	return balance.Balance
+}
+
+//Available balance of currency not reserved by open orders.
+func (b *BalanceBook) Available(currency string) decimal {
+	balance, _ := b.Balance(currency)
+	return balance.Available
+}
+
+//Pending deposits of currency not yet credited.
+func (b *BalanceBook) Pending(currency string) decimal {
+	balance, _ := b.Balance(currency)
+	return balance.Pending
+}
+
+//Balances every currency seen.
+func (b *BalanceBook) Balances() []AccountBalance {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	result := make([]AccountBalance, 0, len(b.balances))
+	for _, booked := range b.balances {
+		result = append(result, booked.AccountBalance)
+	}
+
+	return result
+}
+
+//Nonce of the newest delta applied.
+func (b *BalanceBook) Nonce() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.nonce
+}
+
+//LastReconcile time of the last successful REST snapshot.
+func (b *BalanceBook) LastReconcile() time.Time {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.refreshed
+}
+
+/*
+Reconcile load a REST snapshot now.  Currencies updated by a delta while the snapshot was in flight keep the delta's
+values, since the snapshot may predate it.  Deltas that arrive later with a nonce older than the snapshot are ignored.
+*/
+func (b *BalanceBook) Reconcile() error {
+	b.mutex.RLock()
+	started := time.Now()
+	startNonce, sequence := b.nonce, b.sequence
+	b.mutex.RUnlock()
+
+	snapshot, balanceErr := b.client.AccountGetBalances()
+	if balanceErr != nil {
+		return balanceErr
+	}
+
+	b.mutex.Lock()
+
+	if sequence != b.sequence {
+		//the nonces seen before the snapshot belong to the old sequence.
+		startNonce = 0
+	}
+
+	firstLoad := b.refreshed.IsZero()
+	var changes []BalanceChange
+
+	listed := make(map[string]bool, len(snapshot))
+
+	for _, balance := range snapshot {
+		currency := strings.ToUpper(balance.Currency)
+		listed[currency] = true
+
+		booked, known := b.balances[currency]
+
+		if known && booked.updated.After(started) {
+			continue
+		}
+
+		if !known {
+			booked = &bookedBalance{}
+			b.balances[currency] = booked
+		}
+
+		previous := booked.AccountBalance
+		booked.AccountBalance = balance
+		booked.updated = started
+		booked.nonce = startNonce
+
+		if !firstLoad && !sameBalance(previous, balance) {
+			changes = append(changes, BalanceChange{Type: BalanceDrift, Currency: currency, Previous: previous, Current: balance})
+		}
+	}
+
+	for currency, booked := range b.balances {
+		if listed[currency] || booked.updated.After(started) {
+			continue
+		}
+
+		delete(b.balances, currency)
+		changes = append(changes, BalanceChange{Type: BalanceDrift, Currency: currency, Previous: booked.AccountBalance, Current: AccountBalance{Currency: currency}})
+	}
+
+	//the snapshot covers every delta sent before it was taken, missed or not.
+	for nonce := range b.missing {
+		if nonce <= startNonce {
+			delete(b.missing, nonce)
+		}
+	}
+
+	b.refreshed = started
+	b.mutex.Unlock()
+
+	for _, change := range changes {
+		b.publish(change)
+	}
+
+	return nil
+}
+
+func sameBalance(a, b AccountBalance) bool {
+	return absDecimal(a.Balance-b.Balance) < quantityEpsilon &&
+		absDecimal(a.Available-b.Available) < quantityEpsilon &&
+		absDecimal(a.Pending-b.Pending) < quantityEpsilon
+}
+
+func absDecimal(value decimal) decimal {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
+
+func (b *BalanceBook) apply(delta socketPayloads.Balance) {
+	currency := strings.ToUpper(delta.Currency)
+	now := time.Now()
+
+	b.mutex.Lock()
+
+	reconcile := false
+
+	if b.nonce > 0 && delta.Nonce <= b.nonce && now.Sub(b.nonceAt) > reorderWindow {
+		//too late to be out of order, so the socket has started a new sequence (it does on reconnect).
+		b.sequence++
+		b.nonce = 0
+		b.missing = make(map[int]time.Time)
+
+		for _, booked := range b.balances {
+			booked.nonce = 0
+		}
+
+		reconcile = true
+	}
+
+	booked, known := b.balances[currency]
+	if known && delta.Nonce <= booked.nonce {
+		//the currency's balance already comes from a newer delta or snapshot.
+		b.mutex.Unlock()
+		return
+	}
+
+	delete(b.missing, delta.Nonce)
+
+	if b.nonce > 0 && delta.Nonce > b.nonce+1 {
+		if delta.Nonce-b.nonce-1 > maxPending {
+			reconcile = true
+		} else {
+			for nonce := b.nonce + 1; nonce < delta.Nonce; nonce++ {
+				b.missing[nonce] = now
+			}
+
+			time.AfterFunc(reorderWindow, b.expireGaps)
+		}
+	}
+
+	if delta.Nonce > b.nonce {
+		b.nonce = delta.Nonce
+		b.nonceAt = now
+	}
+
+	if !known {
+		booked = &bookedBalance{}
+		b.balances[currency] = booked
+	}
+
+	previous := booked.AccountBalance
+	booked.AccountBalance = AccountBalance{
+		Currency:      currency,
+		Balance:       delta.Balance,
+		Available:     delta.Available,
+		Pending:       delta.Pending,
+		CryptoAddress: delta.CryptoAddress,
+		Requested:     delta.Requested,
+		UUID:          previous.UUID,
+	}
+	booked.updated = now
+	booked.nonce = delta.Nonce
+
+	change := BalanceChange{
+		Type:     BalanceDeltaApplied,
+		Currency: currency,
+		Previous: previous,
+		Current:  booked.AccountBalance,
+		Nonce:    delta.Nonce,
+		Time:     now,
+	}
+	b.mutex.Unlock()
+
+	b.publish(change)
+
+	if reconcile {
+		b.requestReconcile()
+	}
+}
+
+//expireGaps reconcile if a delta skipped over has not arrived within reorderWindow.
+func (b *BalanceBook) expireGaps() {
+	b.mutex.Lock()
+	expired := false
+	for nonce, seen := range b.missing {
+		if time.Since(seen) >= reorderWindow {
+			delete(b.missing, nonce)
+			expired = true
+		}
+	}
+	b.mutex.Unlock()
+
+	if expired {
+		b.requestReconcile()
+	}
+}
+
+//requestReconcile let the worker reconcile rather than blocking the socket.
+func (b *BalanceBook) requestReconcile() {
+	select {
+	case b.poke <- struct{}{}:
+	default:
+	}
+}
+
+func (b *BalanceBook) run(interval time.Duration) {
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-b.quit:
+			return
+		case <-tick:
+		case <-b.poke:
+		}
+
+		if reconcileErr := b.Reconcile(); reconcileErr != nil {
+			select {
+			case b.errChan <- reconcileErr:
+			default:
+			}
+		}
+	}
+}
+
+func (b *BalanceBook) publish(change BalanceChange) {
+	if change.Time.IsZero() {
+		change.Time = time.Now()
+	}
+
+	b.subscriberMutex.RLock()
+	defer b.subscriberMutex.RUnlock()
+
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- change:
+		default:
+		}
+	}
+}
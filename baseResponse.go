@@ -1,9 +1,22 @@
 package bittrex
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 type baseResponse struct {
 	Success bool            `json:"success"`
 	Message string          `json:"message"`
 	Result  json.RawMessage `json:"result"`
 }
+
+//APIError the api answered, but refused the request (success false).
+type APIError struct {
+	Endpoint string
+	Message  string
+}
+
+func (e APIError) Error() string {
+	return fmt.Sprintf("Send Request Endpoint - %s: %s", e.Endpoint, e.Message)
+}
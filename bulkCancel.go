@@ -0,0 +1,189 @@
+package bittrex
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+const (
+	//cancels in flight at once.
+	bulkCancelConcurrency int = 4
+	//minimum spacing between cancel requests, keeping a large cancel well inside the api rate limit.
+	bulkCancelInterval time.Duration = 250 * time.Millisecond
+	//how long to wait for an order delta confirming a cancel before asking account/getorder.
+	bulkCancelDeltaWait time.Duration = 3 * time.Second
+	//account/getorder attempts before a cancel is reported unverified.
+	bulkCancelVerifyAttempts int = 3
+)
+
+//CancelResult outcome of cancelling one order during CancelAll or CancelAllOrders.
+type CancelResult struct {
+	OrderUUID string
+	Market    string
+	OrderType string
+
+	//Requested market/cancel accepted the request.
+	Requested bool
+	//Verified the order is confirmed closed, by an order delta or account/getorder.
+	Verified bool
+	//Filled the order turned out to have filled completely before it could be cancelled.
+	Filled bool
+
+	Err error
+}
+
+//MarshalJSON write Err as its message.
+func (r CancelResult) MarshalJSON() ([]byte, error) {
+	type plain CancelResult
+
+	return json.Marshal(struct {
+		plain
+		Err string `json:",omitempty"`
+	}{plain(r), errorText(r.Err)})
+}
+
+func errorText(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+//CancelAll cancel every open order in market, returning one result per order.
+func (c *Client) CancelAll(market string) ([]CancelResult, error) {
+	if market == "" {
+		return nil, fmt.Errorf("CancelAll - market is required, use CancelAllOrders for every market")
+	}
+
+	return c.cancelOpenOrders(market)
+}
+
+//CancelAllOrders cancel every open order in every market, returning one result per order.
+func (c *Client) CancelAllOrders() ([]CancelResult, error) {
+	return c.cancelOpenOrders("")
+}
+
+func (c *Client) cancelOpenOrders(market string) ([]CancelResult, error) {
+	orders, openErr := c.MarketGetOpenOrders(market)
+	if openErr != nil {
+		return nil, openErr
+	}
+
+	return c.cancelOrders(orders), nil
+}
+
+//cancelOrders cancel each order concurrently within the rate limit, then verify every cancel completed.
+func (c *Client) cancelOrders(orders []OrderDescription) []CancelResult {
+	results := make([]CancelResult, len(orders))
+
+	if len(orders) == 0 {
+		return results
+	}
+
+	//closed orders reported over the socket, so most cancels verify without another rest call.
+	var closedMutex sync.Mutex
+	closed := make(map[string]chan int, len(orders))
+	for _, order := range orders {
+		closed[order.OrderUUID] = make(chan int, 1)
+	}
+
+	removeListener := c.addOrderListener(func(delta socketPayloads.OrderResponse) {
+		if delta.Type != socketPayloads.OrderDeltaCancel && delta.Type != socketPayloads.OrderDeltaFill {
+			return
+		}
+
+		closedMutex.Lock()
+		defer closedMutex.Unlock()
+
+		if ch, ok := closed[delta.Order.OrderUUID]; ok {
+			ch <- delta.Type
+			delete(closed, delta.Order.OrderUUID)
+		}
+	})
+	defer removeListener()
+
+	throttle := time.NewTicker(bulkCancelInterval)
+	defer throttle.Stop()
+
+	slots := make(chan struct{}, bulkCancelConcurrency)
+	var wait sync.WaitGroup
+
+	for i, order := range orders {
+		closedMutex.Lock()
+		confirmation := closed[order.OrderUUID]
+		closedMutex.Unlock()
+
+		slots <- struct{}{}
+		<-throttle.C
+
+		wait.Add(1)
+		go func(i int, order OrderDescription, confirmation chan int) {
+			defer wait.Done()
+			defer func() { <-slots }()
+
+			results[i] = c.cancelAndVerify(order, confirmation)
+		}(i, order, confirmation)
+	}
+
+	wait.Wait()
+
+	return results
+}
+
+func (c *Client) cancelAndVerify(order OrderDescription, confirmation chan int) CancelResult {
+	result := CancelResult{
+		OrderUUID: order.OrderUUID,
+		Market:    order.Exchange,
+		OrderType: order.OrderType,
+	}
+
+	if _, cancelErr := c.MarketCancel(order.OrderUUID); cancelErr != nil {
+		result.Err = cancelErr
+	} else {
+		result.Requested = true
+	}
+
+	//a failed cancel may still mean the order is gone (filled, or cancelled elsewhere), so verify either way.
+	if confirmation != nil {
+		select {
+		case deltaType := <-confirmation:
+			result.Verified = true
+			result.Filled = deltaType == socketPayloads.OrderDeltaFill
+			result.Err = nil
+			return result
+		case <-time.After(bulkCancelDeltaWait):
+		}
+	}
+
+	for attempt := 0; attempt < bulkCancelVerifyAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * time.Second)
+		}
+
+		current, getErr := c.AccountGetOrder(order.OrderUUID)
+		if getErr != nil {
+			if result.Err == nil {
+				result.Err = getErr
+			}
+			continue
+		}
+
+		if !current.IsOpen {
+			result.Verified = true
+			result.Filled = current.QuantityRemaining <= quantityEpsilon && !current.CancelInitiated
+			result.Err = nil
+			return result
+		}
+	}
+
+	if result.Err == nil {
+		result.Err = fmt.Errorf("order %s still open after cancel", order.OrderUUID)
+	}
+
+	return result
+}
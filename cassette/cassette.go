@@ -0,0 +1,188 @@
+/*
+Package cassette records bittrex REST traffic to disk and plays it back, so code built on sendRequest can be tested without credentials.
+
+Secrets never reach the cassette file: the apikey, nonce and cache busting query values are dropped,
+the apisign header is never stored, and wallet addresses are replaced in both the query and the response body.
+*/
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//Redacted value written in place of anything sensitive.
+const Redacted string = "REDACTED"
+
+//query values that change every call or identify the account.  dropped entirely.
+var droppedParams = map[string]bool{
+	"apikey": true,
+	"nonce":  true,
+	"_":      true,
+}
+
+//query values kept for matching, but with their value hidden.
+var redactedParams = map[string]bool{
+	"address":   true,
+	"paymentid": true,
+}
+
+//response body fields holding wallet addresses.
+var redactedFields = map[string]bool{
+	"Address":       true,
+	"CryptoAddress": true,
+}
+
+//Interaction one recorded request and its response.
+type Interaction struct {
+	Endpoint   string            `json:"endpoint"`
+	Params     map[string]string `json:"params"`
+	StatusCode int               `json:"statusCode"`
+	Body       string            `json:"body"`
+}
+
+//Cassette ordered list of interactions, persisted as json.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+
+	mutex sync.Mutex
+}
+
+//Load read a cassette file.
+func Load(path string) (*Cassette, error) {
+	raw, readErr := ioutil.ReadFile(path)
+	if readErr != nil {
+		return nil, fmt.Errorf("unable to read cassette %s: %+v", path, readErr)
+	}
+
+	var c Cassette
+	if parseErr := json.Unmarshal(raw, &c); parseErr != nil {
+		return nil, fmt.Errorf("unable to parse cassette %s: %+v", path, parseErr)
+	}
+
+	return &c, nil
+}
+
+//Save write the cassette to path, replacing any existing file.
+func (c *Cassette) Save(path string) error {
+	c.mutex.Lock()
+	raw, marshalErr := json.MarshalIndent(c, "", "  ")
+	c.mutex.Unlock()
+
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	tmp := path + ".tmp"
+	if writeErr := ioutil.WriteFile(tmp, raw, 0644); writeErr != nil {
+		return fmt.Errorf("unable to write cassette %s: %+v", path, writeErr)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (c *Cassette) add(i Interaction) {
+	c.mutex.Lock()
+	c.Interactions = append(c.Interactions, i)
+	c.mutex.Unlock()
+}
+
+/*
+normalizeRequest reduce a request url to the values used for matching.
+the endpoint is the path below /api, so v1.1 and v2.0 calls with the same name never collide.
+*/
+func normalizeRequest(u *url.URL) (string, map[string]string) {
+	path := u.Path
+	if idx := strings.Index(path, "/api/"); idx != -1 {
+		path = path[idx+len("/api/"):]
+	}
+
+	//some endpoints are requested with a leading slash, producing a double slash in the path.
+	for strings.Contains(path, "//") {
+		path = strings.Replace(path, "//", "/", -1)
+	}
+
+	endpoint := strings.ToLower(strings.Trim(path, "/"))
+
+	params := map[string]string{}
+	for key, values := range u.Query() {
+		lowerKey := strings.ToLower(key)
+
+		if droppedParams[lowerKey] || len(values) == 0 {
+			continue
+		}
+
+		if redactedParams[lowerKey] {
+			params[key] = Redacted
+			continue
+		}
+
+		params[key] = values[0]
+	}
+
+	return endpoint, params
+}
+
+//matchKey stable string form of an endpoint and its normalized params.
+func matchKey(endpoint string, params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, endpoint)
+
+	for _, key := range keys {
+		parts = append(parts, strings.ToLower(key)+"="+params[key])
+	}
+
+	return strings.Join(parts, "&")
+}
+
+//redactBody replace address fields anywhere in a json body.  non json bodies are returned unchanged.
+func redactBody(body []byte) []byte {
+	var decoded interface{}
+
+	//UseNumber keeps numeric values byte for byte, rather than round tripping through float64.
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if decoder.Decode(&decoded) != nil {
+		return body
+	}
+
+	redacted, marshalErr := json.Marshal(redactValue(decoded))
+	if marshalErr != nil {
+		return body
+	}
+
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range typed {
+			if _, isString := inner.(string); isString && redactedFields[key] {
+				typed[key] = Redacted
+				continue
+			}
+			typed[key] = redactValue(inner)
+		}
+	case []interface{}:
+		for i, inner := range typed {
+			typed[i] = redactValue(inner)
+		}
+	}
+
+	return value
+}
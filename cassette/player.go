@@ -0,0 +1,81 @@
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+/*
+Player http.RoundTripper answering requests from a cassette without touching the network.
+Requests are matched by endpoint and normalized params.  When the same request was recorded several times
+the responses are replayed in recorded order, and the last one is repeated once they run out.
+*/
+type Player struct {
+	mutex     sync.Mutex
+	responses map[string][]Interaction
+	served    map[string]int
+}
+
+//NewPlayer constructor for Player.
+func NewPlayer(c *Cassette) *Player {
+	p := &Player{
+		responses: make(map[string][]Interaction),
+		served:    make(map[string]int),
+	}
+
+	for _, interaction := range c.Interactions {
+		key := matchKey(interaction.Endpoint, interaction.Params)
+		p.responses[key] = append(p.responses[key], interaction)
+	}
+
+	return p
+}
+
+//LoadPlayer read a cassette file and construct a Player from it.
+func LoadPlayer(path string) (*Player, error) {
+	c, loadErr := Load(path)
+	if loadErr != nil {
+		return nil, loadErr
+	}
+
+	return NewPlayer(c), nil
+}
+
+//RoundTrip implement RoundTripper interface
+func (p *Player) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint, params := normalizeRequest(req.URL)
+	key := matchKey(endpoint, params)
+
+	p.mutex.Lock()
+	recorded, ok := p.responses[key]
+	index := p.served[key]
+	if ok && index < len(recorded)-1 {
+		p.served[key] = index + 1
+	}
+	p.mutex.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("cassette - no recorded interaction for %s", key)
+	}
+
+	if index >= len(recorded) {
+		index = len(recorded) - 1
+	}
+
+	interaction := recorded[index]
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(interaction.Body))),
+		ContentLength: int64(len(interaction.Body)),
+		Request:       req,
+	}, nil
+}
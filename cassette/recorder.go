@@ -0,0 +1,64 @@
+package cassette
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+)
+
+//Recorder http.RoundTripper passing requests upstream and capturing the redacted exchange.
+type Recorder struct {
+	upstream http.RoundTripper
+	cassette *Cassette
+}
+
+//NewRecorder constructor for Recorder.  a nil upstream uses http.DefaultTransport.
+func NewRecorder(upstream http.RoundTripper) *Recorder {
+	if upstream == nil {
+		upstream = http.DefaultTransport
+	}
+
+	return &Recorder{
+		upstream: upstream,
+		cassette: &Cassette{},
+	}
+}
+
+//RoundTrip implement RoundTripper interface
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.upstream.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, readErr := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	//the caller still gets the untouched body.
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	endpoint, params := normalizeRequest(req.URL)
+
+	r.cassette.add(Interaction{
+		Endpoint:   endpoint,
+		Params:     params,
+		StatusCode: resp.StatusCode,
+		Body:       string(redactBody(body)),
+	})
+
+	return resp, nil
+}
+
+//Cassette the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	return r.cassette
+}
+
+//Save write the interactions recorded so far to path.
+func (r *Recorder) Save(path string) error {
+	return r.cassette.Save(path)
+}
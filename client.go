@@ -3,6 +3,7 @@ package bittrex
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"sync"
 	"time"
 
@@ -12,27 +13,51 @@ import (
 
 //Client object representing connection to bittrex api.
 type Client struct {
-	apiKey       string
-	apiSecret    string
-	timeout      time.Duration
+	apiKey    string
+	apiSecret string
+	timeout   time.Duration
+
+	//attachMutex guards socketClient, the socket recorder, the session guard, the risk manager and the metrics, which
+	//the socket and REST goroutines read while they are replaced.
+	attachMutex  sync.RWMutex
 	socketClient *signalr.Client
 
+	//transport used for REST calls.  nil uses http.DefaultTransport.
+	httpTransport http.RoundTripper
+
+	marketRegistry  *MarketRegistry
+	orderNormalizer *OrderNormalizer
+	sessionGuard    *SessionGuard
+	riskManager     *RiskManager
+
+	withdrawalPolicy *WithdrawalPolicy
+
+	socketRecorder *signalr.Recorder
+
+	metrics *Metrics
+
 	orderSubscription   chan socketPayloads.OrderResponse
 	balanceSubscription chan socketPayloads.BalanceDelta
 
 	summaryDeltaMutex         sync.RWMutex
 	summaryDeltaSubscriptions map[string]chan socketPayloads.Summary
 	isSubbedToSummaryDelta    bool
+	summarySubscribeMutex     sync.Mutex
 
 	exchangeDeltaMutex         sync.RWMutex
 	exchangeDeltaSubscriptions map[string]chan socketPayloads.ExchangeDelta
 
+	exchangeSubscribedMutex sync.Mutex
+	exchangeSubscribed      map[string]bool
+
 	summaryLiteDeltaMutex         sync.RWMutex
 	summaryLiteDeltaSubscriptions map[string]chan socketPayloads.SummaryLiteDelta
 	isSubbedToSummaryLiteDelta    bool
 
 	errChanMutex sync.RWMutex
 	errChan      chan error
+
+	listeners socketListeners
 }
 
 //New construct a new Client object representing an interface to the various bittrex APIs.
@@ -72,6 +97,19 @@ func (c *Client) ConnectWebSocket() error {
 	return nil
 }
 
+//socket the current signalr client, nil until ConnectWebSocket has connected one.
+func (c *Client) socket() *signalr.Client {
+	c.attachMutex.RLock()
+	defer c.attachMutex.RUnlock()
+
+	return c.socketClient
+}
+
+//SetHTTPTransport replace the transport used for v1.1 and v2.0 REST calls, such as a cassette recorder or player.
+func (c *Client) SetHTTPTransport(transport http.RoundTripper) {
+	c.httpTransport = transport
+}
+
 func (c *Client) connectNewSignalClient() error {
 	client, clientErr := signalr.New()
 
@@ -79,11 +117,19 @@ func (c *Client) connectNewSignalClient() error {
 		return clientErr
 	}
 
+	if m := c.attachedMetrics(); m != nil {
+		client.SetObserver(m.observer())
+	}
+
 	if connectErr := client.Connect(websocketBaseURI, []string{websocketHub}); connectErr != nil {
 		return fmt.Errorf("Unable to create bittrex signal client at url %s:  %+v", websocketBaseURI, connectErr)
 	}
 
+	//the recorder is handed over in the same step, so one attached meanwhile is not missed.
+	c.attachMutex.Lock()
+	client.SetRecorder(c.socketRecorder)
 	c.socketClient = client
+	c.attachMutex.Unlock()
 
 	return nil
 }
@@ -136,8 +182,11 @@ func (c *Client) socketOnClientMethod(hub, method string, arguments []json.RawMe
 		var decodedArg []byte
 		decodedArg, parseErr = socketPayloads.Parse(arg)
 
+		c.attachedMetrics().socketMessage(method)
+
 		if parseErr != nil {
-			fmt.Println("parse error!", parseErr.Error())
+			c.socketDecodeError(method, parseErr)
+			continue
 		}
 
 		switch method {
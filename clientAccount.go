@@ -109,6 +109,21 @@ api call
 */
 func (c *Client) AccountWithdraw(currency string, quantity decimal, address string, paymentID string) (TransactionID, error) {
 
+	if validateErr := c.validateWithdrawal(currency); validateErr != nil {
+		return TransactionID{}, validateErr
+	}
+
+	release, policyErr := c.authorizeWithdrawal(WithdrawalRequest{
+		Currency:  currency,
+		Quantity:  quantity,
+		Address:   address,
+		PaymentID: paymentID,
+	})
+
+	if policyErr != nil {
+		return TransactionID{}, policyErr
+	}
+
 	params := map[string]string{
 		"apikey":   c.apiKey,
 		"currency": currency,
@@ -123,6 +138,11 @@ func (c *Client) AccountWithdraw(currency string, quantity decimal, address stri
 	parsedResponse, parseErr := c.sendRequest("account/withdraw", params)
 
 	if parseErr != nil {
+		//only a refusal from the api is known not to have moved funds; a timeout may still have.
+		if _, refused := parseErr.(APIError); refused {
+			release()
+		}
+
 		return TransactionID{}, parseErr
 	}
 
@@ -239,6 +259,10 @@ func (c *Client) AccountGetWithdrawalHistory(currency string) ([]TransactionHist
 
 	var response []TransactionHistoryDescription
 
+	if err := json.Unmarshal(parsedResponse.Result, &response); err != nil {
+		return nil, fmt.Errorf("api error - account/getwithdrawalhistory %s", err.Error())
+	}
+
 	//clean out responses with nil values.
 	var cleanedResponse []TransactionHistoryDescription
 	defaultVal := TransactionHistoryDescription{}
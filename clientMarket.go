@@ -9,23 +9,35 @@ import (
 // MarketBuyLimit - market/buylimit
 func (c *Client) MarketBuyLimit(market string, quantity decimal, rate decimal) (TransactionID, error) {
 
+	order, reservation, prepareErr := c.prepareOrder(market, OrderSideBuy, quantity, rate)
+	if prepareErr != nil {
+		return TransactionID{}, prepareErr
+	}
+
 	params := map[string]string{
 		"apikey":   c.apiKey,
 		"market":   market,
-		"quantity": strconv.FormatFloat(quantity, 'f', 8, 64),
-		"rate":     strconv.FormatFloat(rate, 'f', 8, 64),
+		"quantity": strconv.FormatFloat(order.Quantity, 'f', 8, 64),
+		"rate":     strconv.FormatFloat(order.Rate, 'f', 8, 64),
 	}
 
 	parsedResponse, parseErr := c.sendRequest("market/buylimit", params)
 
 	if parseErr != nil {
+		c.riskOrderFailed(reservation)
 		return TransactionID{}, parseErr
 	}
 
 	var response TransactionID
 
-	if err := json.Unmarshal(parsedResponse.Result, &response); err != nil {
-		return TransactionID{}, fmt.Errorf("api error - market/buylimit: %s", err.Error())
+	unmarshalErr := json.Unmarshal(parsedResponse.Result, &response)
+
+	//the order was accepted even if the response can't be read, so it still counts towards the risk limits,
+	//and is reported as accepted rather than inviting a retry that would place it twice.
+	c.riskOrderPlaced(reservation, response.UUID)
+
+	if unmarshalErr != nil {
+		return response, OrderAcceptedError{"market/buylimit", unmarshalErr.Error()}
 	}
 
 	return response, nil
@@ -34,23 +46,35 @@ func (c *Client) MarketBuyLimit(market string, quantity decimal, rate decimal) (
 // MarketSellLimit - market/selllimit
 func (c *Client) MarketSellLimit(market string, quantity decimal, rate decimal) (TransactionID, error) {
 
+	order, reservation, prepareErr := c.prepareOrder(market, OrderSideSell, quantity, rate)
+	if prepareErr != nil {
+		return TransactionID{}, prepareErr
+	}
+
 	params := map[string]string{
 		"apikey":   c.apiKey,
 		"market":   market,
-		"quantity": strconv.FormatFloat(quantity, 'f', 8, 64),
-		"rate":     strconv.FormatFloat(rate, 'f', 8, 64),
+		"quantity": strconv.FormatFloat(order.Quantity, 'f', 8, 64),
+		"rate":     strconv.FormatFloat(order.Rate, 'f', 8, 64),
 	}
 
 	parsedResponse, parseErr := c.sendRequest("market/selllimit", params)
 
 	if parseErr != nil {
+		c.riskOrderFailed(reservation)
 		return TransactionID{}, parseErr
 	}
 
 	var response TransactionID
 
-	if err := json.Unmarshal(parsedResponse.Result, &response); err != nil {
-		return TransactionID{}, fmt.Errorf("api error - market/selllimit %s", err.Error())
+	unmarshalErr := json.Unmarshal(parsedResponse.Result, &response)
+
+	//the order was accepted even if the response can't be read, so it still counts towards the risk limits,
+	//and is reported as accepted rather than inviting a retry that would place it twice.
+	c.riskOrderPlaced(reservation, response.UUID)
+
+	if unmarshalErr != nil {
+		return response, OrderAcceptedError{"market/selllimit", unmarshalErr.Error()}
 	}
 
 	return response, nil
@@ -73,14 +97,20 @@ func (c *Client) MarketCancel(uuid string) (bool, error) {
 	return true, nil
 }
 
-// MarketGetOpenOrders - market/getopenorders
+/*
+MarketGetOpenOrders - market/getopenorders
+market is optional param.  set it to empty string to get open orders for all markets.
+*/
 func (c *Client) MarketGetOpenOrders(market string) ([]OrderDescription, error) {
 
 	params := map[string]string{
-		"market": market,
 		"apikey": c.apiKey,
 	}
 
+	if market != "" {
+		params["market"] = market
+	}
+
 	parsedResponse, parseErr := c.sendRequest("market/getopenorders", params)
 
 	if parseErr != nil {
@@ -1,21 +1,37 @@
 package bittrex
 
-import "strconv"
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+//OrderType how an order is priced.
+type OrderType string
 
-//Order Types
+//Order Types.  untyped, so they still work where a string is expected.
 const (
 	OrderTypeLimit  = "LIMIT"
 	OrderTypeMarket = "MARKET"
 )
 
-//Order Time In Effect
+//TimeInForce how long an order may rest on the book.
+type TimeInForce string
+
+//Order Time In Effect.  untyped, so they still work where a string is expected.
 const (
 	OrderTimeGTC = "GOOD_TIL_CANCELLED"
 	OrderTimeIOC = "IMMEDIATE_OR_CANCEL"
-	OrderTime    = "FILL_OR_KILL"
+	OrderTimeFOK = "FILL_OR_KILL"
+
+	//OrderTime Deprecated: unclear name for FILL_OR_KILL, use OrderTimeFOK.
+	OrderTime = OrderTimeFOK
 )
 
-//Order Conditions
+//OrderCondition trigger for a conditional order.
+type OrderCondition string
+
+//Order Conditions.  untyped, so they still work where a string is expected.
 const (
 	OrderConditionNone = "NONE"
 	OrderConditionGT   = "GREATER_THAN"
@@ -25,56 +41,212 @@ const (
 )
 
 /*
-KeyMarketTradeSell generate a sell order using the bittrex v2 rest api (undocumented)
+OrderRequest arguments for PlaceOrder.
+Zero values default to a LIMIT, GOOD_TIL_CANCELLED order with no condition.
+ConditionTarget is a rate for every condition except STOP_LOSS_PERCENTAGE, where it is a percentage.
+*/
+type OrderRequest struct {
+	Market          string
+	Side            OrderSide
+	Type            OrderType
+	Quantity        decimal
+	Rate            decimal
+	TimeInForce     TimeInForce
+	Condition       OrderCondition
+	ConditionTarget decimal
+}
+
+//OrderValidationError returned when an OrderRequest is rejected before being sent.
+type OrderValidationError struct {
+	Field  string
+	Reason string
+}
+
+//Error implement error interface
+func (e OrderValidationError) Error() string {
+	return fmt.Sprintf("validate order %s: %s", e.Field, e.Reason)
+}
+
+/*
+OrderAcceptedError returned by PlaceOrder, MarketBuyLimit and MarketSellLimit when the exchange accepted the order but
+its response could not be read.  The order is live, so it must not be retried; its id arrives on the orders chan.
+*/
+type OrderAcceptedError struct {
+	Endpoint string
+	Reason   string
+}
+
+//Error implement error interface
+func (e OrderAcceptedError) Error() string {
+	return fmt.Sprintf("api error - %s order accepted, but response unreadable: %s", e.Endpoint, e.Reason)
+}
+
+//PlacedOrder result body of v2.0 /key/market/tradebuy and /key/market/tradesell
+//OrderID will be empty if the exchange left it out, in which case the orders chan is the only way to learn it.
+type PlacedOrder struct {
+	OrderID        string  `json:"OrderId"`
+	MarketName     string  `json:"MarketName"`
+	MarketCurrency string  `json:"MarketCurrency"`
+	BuyOrSell      string  `json:"BuyOrSell"`
+	OrderType      string  `json:"OrderType"`
+	Quantity       decimal `json:"Quantity"`
+	Rate           decimal `json:"Rate"`
+
+	//Normalized the values sent, alongside the values requested.
+	Normalized NormalizedOrder `json:"-"`
+}
+
+func (o OrderRequest) withDefaults() OrderRequest {
+	if o.Type == "" {
+		o.Type = OrderTypeLimit
+	}
+
+	if o.TimeInForce == "" {
+		o.TimeInForce = OrderTimeGTC
+	}
+
+	if o.Condition == "" {
+		o.Condition = OrderConditionNone
+	}
+
+	return o
+}
+
+//Validate reject illegal combinations of type, time in force and condition.
+func (o OrderRequest) Validate() error {
+	o = o.withDefaults()
+
+	if o.Market == "" {
+		return OrderValidationError{"Market", "required"}
+	}
+
+	if o.Side != OrderSideBuy && o.Side != OrderSideSell {
+		return OrderValidationError{"Side", fmt.Sprintf("unknown side %q", o.Side)}
+	}
+
+	if o.Quantity <= 0 {
+		return OrderValidationError{"Quantity", "must be greater than zero"}
+	}
+
+	switch o.Type {
+	case OrderTypeLimit:
+		if o.Rate <= 0 {
+			return OrderValidationError{"Rate", "limit orders require a rate greater than zero"}
+		}
+	case OrderTypeMarket:
+		if o.TimeInForce == OrderTimeGTC {
+			return OrderValidationError{"TimeInForce", "market orders cannot rest on the book, use IMMEDIATE_OR_CANCEL or FILL_OR_KILL"}
+		}
+	default:
+		return OrderValidationError{"Type", fmt.Sprintf("unknown order type %q", o.Type)}
+	}
+
+	switch o.TimeInForce {
+	case OrderTimeGTC, OrderTimeIOC, OrderTimeFOK:
+	default:
+		return OrderValidationError{"TimeInForce", fmt.Sprintf("unknown time in force %q", o.TimeInForce)}
+	}
+
+	switch o.Condition {
+	case OrderConditionNone:
+		if o.ConditionTarget != 0 {
+			return OrderValidationError{"ConditionTarget", "must be zero when there is no condition"}
+		}
+	case OrderConditionGT, OrderConditionLT, OrderConditionSLF, OrderConditionSLP:
+		if o.Type != OrderTypeLimit {
+			return OrderValidationError{"Condition", "conditional orders must be limit orders"}
+		}
+
+		if o.TimeInForce != OrderTimeGTC {
+			return OrderValidationError{"Condition", "conditional orders must be GOOD_TIL_CANCELLED"}
+		}
+
+		if o.ConditionTarget <= 0 {
+			return OrderValidationError{"ConditionTarget", "must be greater than zero for conditional orders"}
+		}
+
+		if o.Condition == OrderConditionSLP && o.ConditionTarget >= 100 {
+			return OrderValidationError{"ConditionTarget", "stop loss percentage must be below 100"}
+		}
+	default:
+		return OrderValidationError{"Condition", fmt.Sprintf("unknown condition %q", o.Condition)}
+	}
+
+	return nil
+}
+
+/*
+PlaceOrder place an order using the bittrex v2 rest api (undocumented)
 values for query string come from https://github.com/ericsomdahl/python-bittrex/issues/35
-timeInEffect and conditionType should use the constants found in this file.
-Hardcoded to only place limit orders.
+The request is validated, normalized and checked against the market registry (when attached) before it is sent.
 */
-func (c *Client) KeyMarketTradeSell(
-	market string,
-	quantity float64,
-	rate float64,
-	timeInEffect string,
-	conditionType string,
-	conditionTarget float64,
-) (bool, error) {
+func (c *Client) PlaceOrder(request OrderRequest) (PlacedOrder, error) {
+	request = request.withDefaults()
+
+	if validateErr := request.Validate(); validateErr != nil {
+		return PlacedOrder{}, validateErr
+	}
+
+	order, reservation, prepareErr := c.prepareOrder(request.Market, request.Side, request.Quantity, request.Rate)
+	if prepareErr != nil {
+		return PlacedOrder{}, prepareErr
+	}
+
+	target := request.ConditionTarget
+	if c.orderNormalizer != nil && request.Condition != OrderConditionSLP {
+		target = c.orderNormalizer.RoundRate(target, RoundNearest)
+	}
 
 	targetParam := "0"
-	if conditionTarget != 0 {
-		targetParam = strconv.FormatFloat(conditionTarget, 'f', 8, 64)
+	if target != 0 {
+		targetParam = strconv.FormatFloat(target, 'f', 8, 64)
 	}
 
 	params := map[string]string{
 		"useApi2":       "true",
-		"marketName":    market,
-		"orderType":     OrderTypeLimit,
-		"quantity":      strconv.FormatFloat(quantity, 'f', 8, 64),
-		"rate":          strconv.FormatFloat(rate, 'f', 8, 64),
-		"timeInEffect":  timeInEffect,
-		"conditionType": conditionType,
+		"marketName":    request.Market,
+		"orderType":     string(request.Type),
+		"quantity":      strconv.FormatFloat(order.Quantity, 'f', 8, 64),
+		"rate":          strconv.FormatFloat(order.Rate, 'f', 8, 64),
+		"timeInEffect":  string(request.TimeInForce),
+		"conditionType": string(request.Condition),
 		"target":        targetParam,
 	}
 
-	//@todo haven't tested the response to this endpoint to know the format for sure,
-	//so I'm ignoring it and sending the value of 'success'.
-	//if you really want to know the new order id, provide an API key to the client
-	//and subscribe to the orders chan.
-	parseResponse, parseErr := c.sendRequest("key/market/TradeSell", params)
+	endpoint := "key/market/TradeBuy"
+	if request.Side == OrderSideSell {
+		endpoint = "key/market/TradeSell"
+	}
+
+	parsedResponse, parseErr := c.sendRequest(endpoint, params)
 
 	if parseErr != nil {
-		return false, parseErr
+		c.riskOrderFailed(reservation)
+		return PlacedOrder{}, parseErr
 	}
 
-	return parseResponse.Success, nil
+	//the order has been accepted at this point, so a body that can't be parsed is reported
+	//alongside whatever was understood rather than inviting a retry that would place it twice.
+	response := PlacedOrder{Normalized: order}
+
+	unmarshalErr := json.Unmarshal(parsedResponse.Result, &response)
+
+	c.riskOrderPlaced(reservation, response.OrderID)
+
+	if unmarshalErr != nil {
+		return response, OrderAcceptedError{endpoint, unmarshalErr.Error()}
+	}
+
+	return response, nil
 }
 
 /*
-KeyMarketTradeBuy generate a buy order using the bittrex v2 rest api (undocumented)
-values for query string come from https://github.com/ericsomdahl/python-bittrex/issues/35
-timeInEffect and conditionType should use the constants found in this file.
-Hardcoded to only place limit orders.
+KeyMarketTradeSell generate a limit sell order using the bittrex v2 rest api (undocumented)
+Kept for compatibility; use PlaceOrder to place other order types or to get the new order id.
+timeInEffect and conditionType take the OrderTime and OrderCondition constants.  true whenever the order was
+accepted, even if the error reports its response could not be read.
 */
-func (c *Client) KeyMarketTradeBuy(
+func (c *Client) KeyMarketTradeSell(
 	market string,
 	quantity float64,
 	rate float64,
@@ -83,31 +255,55 @@ func (c *Client) KeyMarketTradeBuy(
 	conditionTarget float64,
 ) (bool, error) {
 
-	targetParam := "0"
-	if conditionTarget != 0 {
-		targetParam = strconv.FormatFloat(conditionTarget, 'f', 8, 64)
-	}
+	_, placeErr := c.PlaceOrder(OrderRequest{
+		Market:          market,
+		Side:            OrderSideSell,
+		Type:            OrderTypeLimit,
+		Quantity:        quantity,
+		Rate:            rate,
+		TimeInForce:     TimeInForce(timeInEffect),
+		Condition:       OrderCondition(conditionType),
+		ConditionTarget: conditionTarget,
+	})
 
-	params := map[string]string{
-		"useApi2":       "true",
-		"marketName":    market,
-		"orderType":     OrderTypeLimit,
-		"quantity":      strconv.FormatFloat(quantity, 'f', 8, 64),
-		"rate":          strconv.FormatFloat(rate, 'f', 8, 64),
-		"timeInEffect":  timeInEffect,
-		"conditionType": conditionType,
-		"target":        targetParam,
-	}
+	return tradeAccepted(placeErr), placeErr
+}
 
-	//@todo haven't tested the response to this endpoint to know the format for sure,
-	//so I'm ignoring it and sending the value of 'success'.
-	//if you really want to know the new order id, provide an API key to the client
-	//and subscribe to the orders chan.
-	parseResponse, parseErr := c.sendRequest("key/market/TradeBuy", params)
+/*
+KeyMarketTradeBuy generate a limit buy order using the bittrex v2 rest api (undocumented)
+Kept for compatibility; use PlaceOrder to place other order types or to get the new order id.
+timeInEffect and conditionType take the OrderTime and OrderCondition constants.  true whenever the order was
+accepted, even if the error reports its response could not be read.
+*/
+func (c *Client) KeyMarketTradeBuy(
+	market string,
+	quantity float64,
+	rate float64,
+	timeInEffect string,
+	conditionType string,
+	conditionTarget float64,
+) (bool, error) {
 
-	if parseErr != nil {
-		return false, parseErr
+	_, placeErr := c.PlaceOrder(OrderRequest{
+		Market:          market,
+		Side:            OrderSideBuy,
+		Type:            OrderTypeLimit,
+		Quantity:        quantity,
+		Rate:            rate,
+		TimeInForce:     TimeInForce(timeInEffect),
+		Condition:       OrderCondition(conditionType),
+		ConditionTarget: conditionTarget,
+	})
+
+	return tradeAccepted(placeErr), placeErr
+}
+
+//tradeAccepted whether PlaceOrder got the order onto the exchange.
+func tradeAccepted(placeErr error) bool {
+	if placeErr == nil {
+		return true
 	}
 
-	return parseResponse.Success, nil
+	_, accepted := placeErr.(OrderAcceptedError)
+	return accepted
 }
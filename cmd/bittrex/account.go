@@ -0,0 +1,315 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/technicalviking/bittrex2"
+)
+
+func runBalances(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("balances")
+	all := flags.Bool("all", false, "include zero balances")
+	parseArgs(flags, args, 0, 0)
+
+	balances, balanceErr := client.AccountGetBalances()
+	if balanceErr != nil {
+		return nil, balanceErr
+	}
+
+	res := &result{header: []string{"CURRENCY", "BALANCE", "AVAILABLE", "PENDING"}}
+	var listed []bittrex.AccountBalance
+
+	for _, balance := range balances {
+		if !*all && balance.Balance == 0 && balance.Pending == 0 {
+			continue
+		}
+
+		listed = append(listed, balance)
+		res.rows = append(res.rows, []string{
+			balance.Currency,
+			formatDecimal(balance.Balance),
+			formatDecimal(balance.Available),
+			formatDecimal(balance.Pending),
+		})
+	}
+
+	res.value = listed
+
+	return res, nil
+}
+
+func runOrders(client *bittrex.Client, args []string) (*result, error) {
+	positional := parseArgs(newFlags("orders"), args, 1, 2)
+
+	market := ""
+	if len(positional) == 2 {
+		market = strings.ToUpper(positional[1])
+	}
+
+	switch positional[0] {
+	case "open":
+		orders, openErr := client.MarketGetOpenOrders(market)
+		if openErr != nil {
+			return nil, openErr
+		}
+
+		res := &result{value: orders, header: []string{"ORDER UUID", "MARKET", "TYPE", "QUANTITY", "REMAINING", "LIMIT", "CONDITION", "OPENED"}}
+		for _, order := range orders {
+			res.rows = append(res.rows, []string{
+				order.OrderUUID,
+				order.Exchange,
+				order.OrderType,
+				formatDecimal(order.Quantity),
+				formatDecimal(order.QuantityRemaining),
+				formatDecimal(order.Limit),
+				formatCondition(order.IsConditional, order.Condition, order.ConditionTarget),
+				formatTime(order.Opened.Time()),
+			})
+		}
+
+		return res, nil
+	case "history":
+		orders, historyErr := client.AccountGetOrderHistory(market)
+		if historyErr != nil {
+			return nil, historyErr
+		}
+
+		res := &result{value: orders, header: []string{"ORDER UUID", "MARKET", "TYPE", "QUANTITY", "REMAINING", "LIMIT", "PRICE PER UNIT", "COMMISSION", "TIME"}}
+		for _, order := range orders {
+			res.rows = append(res.rows, []string{
+				order.OrderUUID,
+				order.Exchange,
+				order.OrderType,
+				formatDecimal(order.Quantity),
+				formatDecimal(order.QuantityRemaining),
+				formatDecimal(order.Limit),
+				formatDecimal(order.PricePerUnit),
+				formatDecimal(order.Commission),
+				formatTime(order.TimeStamp.Time()),
+			})
+		}
+
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("unknown listing %q, use open or history", positional[0])
+}
+
+func formatCondition(conditional bool, condition, target string) string {
+	if !conditional {
+		return ""
+	}
+
+	return condition + " " + target
+}
+
+func runBuy(client *bittrex.Client, args []string) (*result, error) {
+	return placeOrder(client, "buy", bittrex.OrderSideBuy, args)
+}
+
+func runSell(client *bittrex.Client, args []string) (*result, error) {
+	return placeOrder(client, "sell", bittrex.OrderSideSell, args)
+}
+
+/*
+placeOrder a plain limit order goes through MarketBuyLimit or MarketSellLimit.  Orders with a condition or another time
+in force go through PlaceOrder.  Either way the order id is printed.
+*/
+func placeOrder(client *bittrex.Client, name string, side bittrex.OrderSide, args []string) (*result, error) {
+	flags := newFlags(name)
+	condition := flags.String("condition", "", "GREATER_THAN, LESS_THAN, STOP_LOSS_FIXED or STOP_LOSS_PERCENTAGE")
+	target := flags.Float64("target", 0, "condition target rate, or percentage for STOP_LOSS_PERCENTAGE")
+	timeInForce := flags.String("tif", string(bittrex.OrderTimeGTC), "GOOD_TIL_CANCELLED, IMMEDIATE_OR_CANCEL or FILL_OR_KILL")
+	yes := flags.Bool("yes", false, "don't ask for confirmation")
+	positional := parseArgs(flags, args, 3, 3)
+
+	market := strings.ToUpper(positional[0])
+
+	quantity, quantityErr := parseDecimal("quantity", positional[1])
+	if quantityErr != nil {
+		return nil, quantityErr
+	}
+
+	rate, rateErr := parseDecimal("rate", positional[2])
+	if rateErr != nil {
+		return nil, rateErr
+	}
+
+	request := bittrex.OrderRequest{
+		Market:          market,
+		Side:            side,
+		Type:            bittrex.OrderTypeLimit,
+		Quantity:        quantity,
+		Rate:            rate,
+		TimeInForce:     bittrex.TimeInForce(strings.ToUpper(*timeInForce)),
+		Condition:       bittrex.OrderCondition(strings.ToUpper(*condition)),
+		ConditionTarget: *target,
+	}
+
+	if validateErr := request.Validate(); validateErr != nil {
+		return nil, validateErr
+	}
+
+	prompt := fmt.Sprintf("%s %s %s at %s", name, formatDecimal(quantity), market, formatDecimal(rate))
+	if request.Condition != "" && request.Condition != bittrex.OrderConditionNone {
+		prompt += fmt.Sprintf(" when %s %s", request.Condition, strconv.FormatFloat(*target, 'f', -1, 64))
+	}
+
+	if confirmErr := confirm(*yes, "%s?", prompt); confirmErr != nil {
+		return nil, confirmErr
+	}
+
+	res := &result{header: []string{"ORDER UUID", "MARKET", "SIDE", "QUANTITY", "RATE"}}
+	placed := map[string]interface{}{"Market": market, "Side": side, "Quantity": quantity, "Rate": rate}
+
+	var orderID string
+
+	plain := (request.Condition == "" || request.Condition == bittrex.OrderConditionNone) &&
+		(request.TimeInForce == "" || request.TimeInForce == bittrex.OrderTimeGTC)
+
+	switch {
+	case plain && side == bittrex.OrderSideBuy:
+		id, placeErr := client.MarketBuyLimit(market, quantity, rate)
+		if placeErr != nil {
+			return nil, placeErr
+		}
+		orderID = id.UUID
+	case plain:
+		id, placeErr := client.MarketSellLimit(market, quantity, rate)
+		if placeErr != nil {
+			return nil, placeErr
+		}
+		orderID = id.UUID
+	default:
+		order, placeErr := client.PlaceOrder(request)
+		if placeErr != nil {
+			return nil, placeErr
+		}
+		orderID = order.OrderID
+	}
+
+	placed["OrderUUID"] = orderID
+	res.value = placed
+	res.rows = [][]string{{orderID, market, string(side), formatDecimal(quantity), formatDecimal(rate)}}
+
+	return res, nil
+}
+
+func runCancel(client *bittrex.Client, args []string) (*result, error) {
+	orderIDs := parseArgs(newFlags("cancel"), args, 1, -1)
+
+	res := &result{header: []string{"ORDER UUID", "CANCELLED"}}
+	cancelled := make(map[string]bool)
+
+	for _, orderID := range orderIDs {
+		ok, cancelErr := client.MarketCancel(orderID)
+		if cancelErr != nil {
+			return nil, fmt.Errorf("%s: %s", orderID, cancelErr.Error())
+		}
+
+		cancelled[orderID] = ok
+		res.rows = append(res.rows, []string{orderID, strconv.FormatBool(ok)})
+	}
+
+	res.value = cancelled
+
+	return res, nil
+}
+
+func runCancelAll(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("cancel-all")
+	yes := flags.Bool("yes", false, "don't ask for confirmation")
+	positional := parseArgs(flags, args, 0, 1)
+
+	var cancelResults []bittrex.CancelResult
+	var cancelErr error
+
+	if len(positional) == 1 {
+		market := strings.ToUpper(positional[0])
+		if confirmErr := confirm(*yes, "cancel every open order in %s?", market); confirmErr != nil {
+			return nil, confirmErr
+		}
+		cancelResults, cancelErr = client.CancelAll(market)
+	} else {
+		if confirmErr := confirm(*yes, "cancel every open order in every market?"); confirmErr != nil {
+			return nil, confirmErr
+		}
+		cancelResults, cancelErr = client.CancelAllOrders()
+	}
+
+	if cancelErr != nil {
+		return nil, cancelErr
+	}
+
+	res := &result{value: cancelResults, header: []string{"ORDER UUID", "MARKET", "TYPE", "REQUESTED", "VERIFIED", "FILLED", "ERROR"}}
+	for _, cancelResult := range cancelResults {
+		errText := ""
+		if cancelResult.Err != nil {
+			errText = cancelResult.Err.Error()
+		}
+
+		res.rows = append(res.rows, []string{
+			cancelResult.OrderUUID,
+			cancelResult.Market,
+			cancelResult.OrderType,
+			strconv.FormatBool(cancelResult.Requested),
+			strconv.FormatBool(cancelResult.Verified),
+			strconv.FormatBool(cancelResult.Filled),
+			errText,
+		})
+	}
+
+	return res, nil
+}
+
+func runDepositAddress(client *bittrex.Client, args []string) (*result, error) {
+	positional := parseArgs(newFlags("deposit-address"), args, 1, 1)
+
+	address, addressErr := client.AccountGetDepositAddress(strings.ToUpper(positional[0]))
+	if addressErr != nil {
+		return nil, addressErr
+	}
+
+	return &result{
+		value:  address,
+		header: []string{"CURRENCY", "ADDRESS"},
+		rows:   [][]string{{address.Currency, address.Address}},
+	}, nil
+}
+
+func runWithdraw(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("withdraw")
+	paymentID := flags.String("payment-id", "", "payment id, memo or tag, for currencies that need one")
+	yes := flags.Bool("yes", false, "don't ask for confirmation")
+	positional := parseArgs(flags, args, 3, 3)
+
+	currency := strings.ToUpper(positional[0])
+	address := positional[2]
+
+	quantity, quantityErr := parseDecimal("quantity", positional[1])
+	if quantityErr != nil {
+		return nil, quantityErr
+	}
+
+	prompt := fmt.Sprintf("withdraw %s %s to %s", formatDecimal(quantity), currency, address)
+	if *paymentID != "" {
+		prompt += " with payment id " + *paymentID
+	}
+
+	if confirmErr := confirm(*yes, "%s?", prompt); confirmErr != nil {
+		return nil, confirmErr
+	}
+
+	id, withdrawErr := client.AccountWithdraw(currency, quantity, address, *paymentID)
+	if withdrawErr != nil {
+		return nil, withdrawErr
+	}
+
+	return &result{
+		value:  id,
+		header: []string{"UUID", "CURRENCY", "QUANTITY", "ADDRESS"},
+		rows:   [][]string{{id.UUID, currency, formatDecimal(quantity), address}},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//credentials api key pair, as stored in the config file.
+type credentials struct {
+	Key    string `json:"key"`
+	Secret string `json:"secret"`
+}
+
+/*
+loadCredentials read the config file, then let BITTREX_API_KEY and BITTREX_API_SECRET override it.
+A missing file is only an error when it was named explicitly.
+*/
+func loadCredentials(path string) (credentials, error) {
+	var creds credentials
+
+	explicit := path != ""
+	if !explicit {
+		path = os.Getenv("BITTREX_CONFIG")
+		explicit = path != ""
+	}
+
+	if !explicit {
+		if home, homeErr := os.UserHomeDir(); homeErr == nil {
+			path = filepath.Join(home, ".bittrex.json")
+		}
+	}
+
+	if path != "" {
+		raw, readErr := ioutil.ReadFile(path)
+
+		switch {
+		case os.IsNotExist(readErr) && !explicit:
+		case readErr != nil:
+			return creds, readErr
+		default:
+			if parseErr := json.Unmarshal(raw, &creds); parseErr != nil {
+				return creds, fmt.Errorf("unable to parse %s: %s", path, parseErr.Error())
+			}
+
+			if info, statErr := os.Stat(path); statErr == nil && info.Mode().Perm()&0077 != 0 && creds.Secret != "" {
+				fmt.Fprintf(os.Stderr, "bittrex: warning: %s is readable by other users\n", path)
+			}
+		}
+	}
+
+	if key := os.Getenv("BITTREX_API_KEY"); key != "" {
+		creds.Key = key
+	}
+
+	if secret := os.Getenv("BITTREX_API_SECRET"); secret != "" {
+		creds.Secret = secret
+	}
+
+	return creds, nil
+}
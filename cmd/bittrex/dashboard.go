@@ -0,0 +1,878 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+const (
+	dashboardFrameInterval   = 100 * time.Millisecond //least time between redraws
+	dashboardOrdersInterval  = 30 * time.Second       //open orders are reloaded this often, as well as after every order delta
+	dashboardSummaryInterval = 5 * time.Minute        //bid, ask and the previous day's price, which the lite deltas don't carry
+	dashboardFillCount       = 50
+)
+
+//ansi escapes
+const (
+	ansiAltScreen    = "\x1b[?1049h"
+	ansiMainScreen   = "\x1b[?1049l"
+	ansiHideCursor   = "\x1b[?25l"
+	ansiShowCursor   = "\x1b[?25h"
+	ansiHome         = "\x1b[H"
+	ansiClearLine    = "\x1b[K"
+	ansiReset        = "\x1b[0m"
+	ansiBold         = "\x1b[1m"
+	ansiReverse      = "\x1b[7m"
+	ansiRed          = "\x1b[31m"
+	ansiGreen        = "\x1b[32m"
+	ansiDim          = "\x1b[2m"
+	dashboardDivider = "│"
+)
+
+type marketSort int
+
+const (
+	sortVolume marketSort = iota
+	sortChange
+	sortName
+	sortLast
+)
+
+var marketSortNames = []string{"volume", "change", "name", "last"}
+
+type focusPane int
+
+const (
+	focusMarkets focusPane = iota
+	focusOrders
+)
+
+type dashboardMarket struct {
+	name       string
+	last       float64
+	bid        float64
+	ask        float64
+	prevDay    float64
+	baseVolume float64
+}
+
+func (m *dashboardMarket) change() float64 {
+	if m.prevDay <= 0 {
+		return 0
+	}
+
+	return (m.last/m.prevDay - 1) * 100
+}
+
+type dashboardFill struct {
+	at       time.Time
+	side     string
+	rate     float64
+	quantity float64
+}
+
+//styledLine one row of the screen; style is applied after padding, so escapes never count towards the width.
+type styledLine struct {
+	text  string
+	style string
+}
+
+type dashboard struct {
+	client        *bittrex.Client
+	authenticated bool
+
+	mutex   sync.Mutex
+	markets map[string]*dashboardMarket
+	sorted  []*dashboardMarket
+	sortBy  marketSort
+	reverse bool
+	cursor  int
+	offset  int
+
+	selected   string
+	selection  int //bumped on each selection, so a load finishing after a newer one is discarded
+	book       *bittrex.LocalOrderBook
+	removeBook func()
+	fills      []dashboardFill
+	draining   map[string]bool //markets whose exchange delta channel is being read
+
+	orders      []bittrex.OrderDescription
+	orderCursor int
+	balances    *bittrex.BalanceBook
+
+	focus   focusPane
+	status  string
+	confirm func() //action awaiting y, set along with a question in status
+
+	redraw chan struct{}
+	reload chan struct{}
+	quit   chan struct{}
+}
+
+/*
+runDashboard full-screen view of the markets, fed by the summary lite deltas, with the depth ladder and fills of the
+selected market from a LocalOrderBook and the exchange deltas, and the account's open orders and balances.
+*/
+func runDashboard(client *bittrex.Client, args []string) (*result, error) {
+	parseArgs(newFlags("dashboard"), args, 0, 1)
+
+	fd := int(os.Stdin.Fd())
+	if _, _, sizeErr := terminalSize(fd); sizeErr != nil {
+		return nil, fmt.Errorf("not a terminal: %s", sizeErr.Error())
+	}
+
+	d := &dashboard{
+		client:        client,
+		authenticated: haveCredentials,
+		markets:       make(map[string]*dashboardMarket),
+		draining:      make(map[string]bool),
+		removeBook:    func() {},
+		redraw:        make(chan struct{}, 1),
+		reload:        make(chan struct{}, 1),
+		quit:          make(chan struct{}),
+	}
+
+	if loadErr := d.loadSummaries(); loadErr != nil {
+		return nil, loadErr
+	}
+
+	if connectErr := client.ConnectWebSocket(); connectErr != nil {
+		return nil, connectErr
+	}
+
+	if subscribeErr := d.subscribeMarkets(); subscribeErr != nil {
+		return nil, subscribeErr
+	}
+
+	if d.authenticated {
+		balances, balanceErr := bittrex.NewBalanceBook(client, time.Minute)
+		if balanceErr != nil {
+			return nil, balanceErr
+		}
+		defer balances.Close()
+		d.balances = balances
+
+		go d.followAccount()
+	}
+
+	d.mutex.Lock()
+	d.sortLocked()
+	if len(args) == 1 {
+		d.selectLocked(strings.ToUpper(args[0]))
+	} else if len(d.sorted) > 0 {
+		d.selectLocked(d.sorted[0].name)
+	}
+	d.mutex.Unlock()
+
+	restore, rawErr := makeRaw(fd)
+	if rawErr != nil {
+		return nil, rawErr
+	}
+	defer restore()
+
+	os.Stdout.WriteString(ansiAltScreen + ansiHideCursor)
+	defer os.Stdout.WriteString(ansiShowCursor + ansiMainScreen)
+
+	go d.readKeys()
+	go d.refreshSummaries()
+
+	d.run(fd)
+
+	d.mutex.Lock()
+	d.removeBook()
+	if d.book != nil {
+		d.book.Close()
+	}
+	d.mutex.Unlock()
+
+	return nil, nil
+}
+
+func (d *dashboard) poke() {
+	select {
+	case d.redraw <- struct{}{}:
+	default:
+	}
+}
+
+func (d *dashboard) setStatus(format string, args ...interface{}) {
+	d.mutex.Lock()
+	d.status = fmt.Sprintf(format, args...)
+	d.mutex.Unlock()
+	d.poke()
+}
+
+func (d *dashboard) loadSummaries() error {
+	summaries, summaryErr := d.client.PublicGetMarketSummaries()
+	if summaryErr != nil {
+		return summaryErr
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for _, summary := range summaries {
+		name := strings.ToUpper(summary.MarketName)
+
+		market, ok := d.markets[name]
+		if !ok {
+			market = &dashboardMarket{name: name}
+			d.markets[name] = market
+		}
+
+		market.last = summary.Last
+		market.bid = summary.Bid
+		market.ask = summary.Ask
+		market.prevDay = summary.PrevDay
+		market.baseVolume = summary.BaseVolume
+	}
+
+	return nil
+}
+
+func (d *dashboard) refreshSummaries() {
+	ticker := time.NewTicker(dashboardSummaryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-ticker.C:
+			if loadErr := d.loadSummaries(); loadErr != nil {
+				d.setStatus("market summaries: %s", loadErr.Error())
+				continue
+			}
+			d.poke()
+		}
+	}
+}
+
+//subscribeMarkets a lite delta channel per market.  Every channel is read for the life of the client, as the socket blocks on it.
+func (d *dashboard) subscribeMarkets() error {
+	d.mutex.Lock()
+	names := make([]string, 0, len(d.markets))
+	for name := range d.markets {
+		names = append(names, name)
+	}
+	d.mutex.Unlock()
+
+	for _, name := range names {
+		deltas, subscribeErr := d.client.SubscribeToMarketSummaryLite(name)
+		if subscribeErr != nil {
+			return subscribeErr
+		}
+
+		go func(deltas chan socketPayloads.SummaryLiteDelta) {
+			for delta := range deltas {
+				d.mutex.Lock()
+				if market, ok := d.markets[strings.ToUpper(delta.MarketName)]; ok {
+					market.last = delta.Last
+					market.baseVolume = delta.BaseVolume
+				}
+				d.mutex.Unlock()
+				d.poke()
+			}
+		}(deltas)
+	}
+
+	return nil
+}
+
+//selectLocked follow market in the ladder and fills.  must be called with the mutex held.
+func (d *dashboard) selectLocked(market string) {
+	if market == d.selected {
+		return
+	}
+
+	d.removeBook()
+	d.removeBook = func() {}
+	if d.book != nil {
+		d.book.Close()
+		d.book = nil
+	}
+
+	d.selected = market
+	d.selection++
+	d.fills = nil
+
+	go d.loadMarket(market, d.selection)
+}
+
+/*
+loadMarket build the book and seed the fills for market, outside the mutex since both take REST calls.  The result is
+kept only if selection is still the latest; selecting A, B then A again starts two loads of A, and the first must not
+replace the second.
+*/
+func (d *dashboard) loadMarket(market string, selection int) {
+	book, bookErr := bittrex.NewLocalOrderBook(d.client, market)
+	if bookErr != nil {
+		d.setStatus("%s book: %s", market, bookErr.Error())
+		return
+	}
+
+	trades, historyErr := d.client.PublicGetMarketHistory(market)
+	if historyErr != nil {
+		d.setStatus("%s history: %s", market, historyErr.Error())
+	}
+
+	deltas, subscribeErr := d.client.SubscribeToExchange(market)
+	if subscribeErr != nil {
+		d.setStatus("%s fills: %s", market, subscribeErr.Error())
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	//read even if the selection has moved on; the socket blocks on an unread channel.
+	if deltas != nil && !d.draining[market] {
+		d.draining[market] = true
+		go d.readFills(market, deltas)
+	}
+
+	if d.selection != selection {
+		//moved on while loading.
+		book.Close()
+		return
+	}
+
+	d.book = book
+	d.removeBook = book.OnUpdate(d.poke)
+
+	d.fills = d.fills[:0]
+	for _, trade := range trades {
+		if len(d.fills) == dashboardFillCount {
+			break
+		}
+		d.fills = append(d.fills, dashboardFill{at: trade.TimeStamp.Time(), side: trade.OrderType, rate: trade.Price, quantity: trade.Quantity})
+	}
+
+	d.poke()
+}
+
+func (d *dashboard) readFills(market string, deltas chan socketPayloads.ExchangeDelta) {
+	for delta := range deltas {
+		if len(delta.Fills) == 0 {
+			continue
+		}
+
+		d.mutex.Lock()
+		if d.selected == market {
+			for _, fill := range delta.Fills {
+				d.fills = append([]dashboardFill{{at: fill.TimeStamp.Get(), side: fill.OrderType, rate: fill.Rate, quantity: fill.Quantity}}, d.fills...)
+			}
+			if len(d.fills) > dashboardFillCount {
+				d.fills = d.fills[:dashboardFillCount]
+			}
+		}
+		d.mutex.Unlock()
+
+		d.poke()
+	}
+}
+
+//followAccount reload the open orders on every order delta, and periodically.
+func (d *dashboard) followAccount() {
+	deltas := d.client.SubscribeToOrderChanges()
+	changes := d.balances.Subscribe()
+
+	ticker := time.NewTicker(dashboardOrdersInterval)
+	defer ticker.Stop()
+
+	d.loadOrders()
+
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-deltas:
+		case <-changes:
+			d.poke()
+			continue
+		case <-ticker.C:
+		case <-d.reload:
+		}
+
+		d.loadOrders()
+	}
+}
+
+func (d *dashboard) loadOrders() {
+	orders, openErr := d.client.MarketGetOpenOrders("")
+	if openErr != nil {
+		d.setStatus("open orders: %s", openErr.Error())
+		return
+	}
+
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Opened.Time().After(orders[j].Opened.Time())
+	})
+
+	d.mutex.Lock()
+	d.orders = orders
+	if d.orderCursor >= len(orders) {
+		d.orderCursor = len(orders) - 1
+	}
+	if d.orderCursor < 0 {
+		d.orderCursor = 0
+	}
+	d.mutex.Unlock()
+
+	d.poke()
+}
+
+func (d *dashboard) cancelOrder(order bittrex.OrderDescription) {
+	if _, cancelErr := d.client.MarketCancel(order.OrderUUID); cancelErr != nil {
+		d.setStatus("cancel %s: %s", order.OrderUUID, cancelErr.Error())
+		return
+	}
+
+	d.setStatus("cancel requested for %s %s", order.Exchange, order.OrderUUID)
+
+	select {
+	case d.reload <- struct{}{}:
+	default:
+	}
+}
+
+func (d *dashboard) readKeys() {
+	buffer := make([]byte, 64)
+
+	for {
+		n, readErr := os.Stdin.Read(buffer)
+		if readErr != nil {
+			close(d.quit)
+			return
+		}
+
+		if !d.handleKeys(string(buffer[:n])) {
+			close(d.quit)
+			return
+		}
+	}
+}
+
+//handleKeys act on one read of input.  false to quit.
+func (d *dashboard) handleKeys(input string) bool {
+	d.mutex.Lock()
+	defer d.poke()
+	defer d.mutex.Unlock()
+
+	for len(input) > 0 {
+		key := input[:1]
+		switch {
+		case strings.HasPrefix(input, "\x1b[A"), strings.HasPrefix(input, "\x1bOA"):
+			key = "up"
+			input = input[3:]
+		case strings.HasPrefix(input, "\x1b[B"), strings.HasPrefix(input, "\x1bOB"):
+			key = "down"
+			input = input[3:]
+		default:
+			input = input[1:]
+		}
+
+		if d.confirm != nil {
+			action := d.confirm
+			d.confirm = nil
+			d.status = ""
+
+			if key == "y" || key == "Y" {
+				go action()
+			}
+			continue
+		}
+
+		switch key {
+		case "q", "Q", "\x03":
+			return false
+		case "\t":
+			if d.focus == focusMarkets && d.authenticated {
+				d.focus = focusOrders
+			} else {
+				d.focus = focusMarkets
+			}
+		case "up", "k":
+			d.moveLocked(-1)
+		case "down", "j":
+			d.moveLocked(1)
+		case "\r", "\n":
+			if d.focus == focusMarkets && d.cursor < len(d.sorted) {
+				d.selectLocked(d.sorted[d.cursor].name)
+			}
+		case "s":
+			d.sortBy = (d.sortBy + 1) % marketSort(len(marketSortNames))
+			d.sortLocked()
+		case "r":
+			d.reverse = !d.reverse
+			d.sortLocked()
+		case "c":
+			if d.focus != focusOrders || d.orderCursor >= len(d.orders) {
+				d.status = "tab to the open orders and pick one to cancel"
+				continue
+			}
+
+			order := d.orders[d.orderCursor]
+			d.status = fmt.Sprintf("cancel %s %s %s of %s at %s? y/n", order.OrderType, order.Exchange,
+				formatDecimal(order.QuantityRemaining), formatDecimal(order.Quantity), formatDecimal(order.Limit))
+			d.confirm = func() { d.cancelOrder(order) }
+		}
+	}
+
+	return true
+}
+
+func (d *dashboard) moveLocked(by int) {
+	if d.focus == focusOrders {
+		d.orderCursor += by
+		if d.orderCursor >= len(d.orders) {
+			d.orderCursor = len(d.orders) - 1
+		}
+		if d.orderCursor < 0 {
+			d.orderCursor = 0
+		}
+		return
+	}
+
+	d.cursor += by
+	if d.cursor >= len(d.sorted) {
+		d.cursor = len(d.sorted) - 1
+	}
+	if d.cursor < 0 {
+		d.cursor = 0
+	}
+}
+
+//sortLocked re-sort the market list, keeping the cursor on the same market.  must be called with the mutex held.
+func (d *dashboard) sortLocked() {
+	var current string
+	if d.cursor < len(d.sorted) {
+		current = d.sorted[d.cursor].name
+	}
+
+	d.sorted = d.sorted[:0]
+	for _, market := range d.markets {
+		d.sorted = append(d.sorted, market)
+	}
+
+	sort.Slice(d.sorted, func(i, j int) bool {
+		a, b := d.sorted[i], d.sorted[j]
+		if d.reverse {
+			a, b = b, a
+		}
+
+		switch d.sortBy {
+		case sortChange:
+			return a.change() > b.change()
+		case sortName:
+			return a.name < b.name
+		case sortLast:
+			return a.last > b.last
+		}
+
+		return a.baseVolume > b.baseVolume
+	})
+
+	for i, market := range d.sorted {
+		if market.name == current {
+			d.cursor = i
+		}
+	}
+}
+
+func (d *dashboard) run(fd int) {
+	resized := make(chan os.Signal, 1)
+	notifyResize(resized)
+
+	throttle := time.NewTicker(dashboardFrameInterval)
+	defer throttle.Stop()
+
+	resort := time.NewTicker(time.Second)
+	defer resort.Stop()
+
+	dirty := true
+
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-d.redraw:
+			dirty = true
+			continue
+		case <-resized:
+			dirty = true
+			continue
+		case <-resort.C:
+			//re-sorting on every delta would make the list jump about.
+			d.mutex.Lock()
+			d.sortLocked()
+			d.mutex.Unlock()
+			dirty = true
+			continue
+		case <-throttle.C:
+		}
+
+		if !dirty {
+			continue
+		}
+		dirty = false
+
+		width, height, sizeErr := terminalSize(fd)
+		if sizeErr != nil || width < 40 || height < 10 {
+			continue
+		}
+
+		os.Stdout.WriteString(d.frame(width, height))
+	}
+}
+
+//frame the whole screen.
+func (d *dashboard) frame(width, height int) string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	bodyHeight := height - 2
+	leftWidth := width / 2
+	if leftWidth > 52 {
+		leftWidth = 52
+	}
+	rightWidth := width - leftWidth - 1
+
+	left := d.marketLines(bodyHeight)
+
+	ladderHeight := bodyHeight * 45 / 100
+	fillsHeight := bodyHeight * 20 / 100
+	ordersHeight := bodyHeight * 20 / 100
+
+	right := d.ladderLines(ladderHeight, rightWidth)
+	right = append(right, d.fillLines(fillsHeight)...)
+	right = append(right, d.orderLines(ordersHeight)...)
+	right = append(right, d.balanceLines(bodyHeight-len(right))...)
+
+	sortDirection := "↓"
+	if d.reverse {
+		sortDirection = "↑"
+	}
+
+	header := fmt.Sprintf(" bittrex  %s  sort: %s %s  socket %s  %s", d.selected, marketSortNames[d.sortBy], sortDirection,
+		d.client.GetWebSocketState().String(), time.Now().UTC().Format("15:04:05 UTC"))
+
+	footer := d.status
+	if footer == "" {
+		footer = " ↑↓ move  enter select market  tab markets/orders  s sort  r reverse  c cancel order  q quit"
+	}
+
+	var screen strings.Builder
+	screen.WriteString(ansiHome)
+	screen.WriteString(ansiReverse + fit(header, width) + ansiReset + ansiClearLine + "\r\n")
+
+	for row := 0; row < bodyHeight; row++ {
+		screen.WriteString(lineAt(left, row).render(leftWidth))
+		screen.WriteString(ansiDim + dashboardDivider + ansiReset)
+		screen.WriteString(lineAt(right, row).render(rightWidth))
+		screen.WriteString(ansiClearLine + "\r\n")
+	}
+
+	footerStyle := ansiDim
+	if d.confirm != nil || d.status != "" {
+		footerStyle = ansiBold
+	}
+	screen.WriteString(footerStyle + fit(footer, width) + ansiReset + ansiClearLine)
+
+	return screen.String()
+}
+
+func lineAt(lines []styledLine, row int) styledLine {
+	if row < len(lines) {
+		return lines[row]
+	}
+
+	return styledLine{}
+}
+
+func (l styledLine) render(width int) string {
+	if l.style == "" {
+		return fit(l.text, width)
+	}
+
+	return l.style + fit(l.text, width) + ansiReset
+}
+
+//fit pad or cut s to exactly width runes.
+func fit(s string, width int) string {
+	count := utf8.RuneCountInString(s)
+	if count <= width {
+		return s + strings.Repeat(" ", width-count)
+	}
+
+	return string([]rune(s)[:width])
+}
+
+func (d *dashboard) marketLines(height int) []styledLine {
+	lines := []styledLine{{text: fmt.Sprintf(" %-12s %14s %8s %12s", "MARKET", "LAST", "24H %", "VOLUME"), style: ansiBold}}
+	rows := height - 1
+
+	if d.cursor < d.offset {
+		d.offset = d.cursor
+	}
+	if d.cursor >= d.offset+rows {
+		d.offset = d.cursor - rows + 1
+	}
+
+	for i := d.offset; i < len(d.sorted) && i < d.offset+rows; i++ {
+		market := d.sorted[i]
+
+		line := styledLine{text: fmt.Sprintf(" %-12s %14.8f %+8.2f %12.2f", market.name, market.last, market.change(), market.baseVolume)}
+		switch {
+		case i == d.cursor && d.focus == focusMarkets:
+			line.style = ansiReverse
+		case market.name == d.selected:
+			line.style = ansiBold
+		case market.change() < 0:
+			line.style = ansiRed
+		case market.change() > 0:
+			line.style = ansiGreen
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+//ladderLines asks above bids, best prices meeting at the spread, with a bar for each level's size.
+func (d *dashboard) ladderLines(height, width int) []styledLine {
+	lines := []styledLine{{text: " BOOK " + d.selected, style: ansiBold}}
+
+	if d.book == nil || !d.book.Synced() {
+		lines = append(lines, styledLine{text: " loading...", style: ansiDim})
+		return padLines(lines, height)
+	}
+
+	depth := (height - 2) / 2
+	asks := d.book.Asks(depth)
+	bids := d.book.Bids(depth)
+
+	largest := 0.0
+	for _, level := range append(append([]bittrex.OrderElement{}, asks...), bids...) {
+		if level.Quantity > largest {
+			largest = level.Quantity
+		}
+	}
+
+	barWidth := width - 34
+	level := func(element bittrex.OrderElement, style string) styledLine {
+		bar := ""
+		if largest > 0 && barWidth > 0 {
+			bar = strings.Repeat("▇", int(element.Quantity/largest*float64(barWidth)+0.5))
+		}
+		return styledLine{text: fmt.Sprintf(" %15.8f %16.8f %s", element.Rate, element.Quantity, bar), style: style}
+	}
+
+	for i := depth - 1; i >= 0; i-- {
+		if i < len(asks) {
+			lines = append(lines, level(asks[i], ansiRed))
+		} else {
+			lines = append(lines, styledLine{})
+		}
+	}
+
+	spread := " spread"
+	if len(asks) > 0 && len(bids) > 0 {
+		spread = fmt.Sprintf(" spread %.8f", asks[0].Rate-bids[0].Rate)
+	}
+	lines = append(lines, styledLine{text: spread, style: ansiDim})
+
+	for _, bid := range bids {
+		lines = append(lines, level(bid, ansiGreen))
+	}
+
+	return padLines(lines, height)
+}
+
+func (d *dashboard) fillLines(height int) []styledLine {
+	lines := []styledLine{{text: " FILLS", style: ansiBold}}
+
+	for _, fill := range d.fills {
+		style := ansiGreen
+		if strings.EqualFold(fill.side, "SELL") {
+			style = ansiRed
+		}
+
+		lines = append(lines, styledLine{text: fmt.Sprintf(" %s %-4s %15.8f %16.8f", fill.at.UTC().Format("15:04:05"), fill.side, fill.rate, fill.quantity), style: style})
+	}
+
+	return padLines(lines, height)
+}
+
+func (d *dashboard) orderLines(height int) []styledLine {
+	lines := []styledLine{{text: " OPEN ORDERS", style: ansiBold}}
+
+	if !d.authenticated {
+		return padLines(append(lines, styledLine{text: " no credentials", style: ansiDim}), height)
+	}
+
+	rows := height - 1
+	offset := 0
+	if d.orderCursor >= rows {
+		offset = d.orderCursor - rows + 1
+	}
+
+	for i := offset; i < len(d.orders) && i < offset+rows; i++ {
+		order := d.orders[i]
+
+		line := styledLine{text: fmt.Sprintf(" %-10s %-10s %14.8f %14.8f @ %.8f", order.Exchange, order.OrderType, order.QuantityRemaining, order.Quantity, order.Limit)}
+		if i == d.orderCursor && d.focus == focusOrders {
+			line.style = ansiReverse
+		}
+
+		lines = append(lines, line)
+	}
+
+	return padLines(lines, height)
+}
+
+func (d *dashboard) balanceLines(height int) []styledLine {
+	lines := []styledLine{{text: " BALANCES", style: ansiBold}}
+
+	if d.balances == nil {
+		return padLines(append(lines, styledLine{text: " no credentials", style: ansiDim}), height)
+	}
+
+	balances := d.balances.Balances()
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Currency < balances[j].Currency })
+
+	for _, balance := range balances {
+		if balance.Balance == 0 && balance.Pending == 0 {
+			continue
+		}
+
+		lines = append(lines, styledLine{text: fmt.Sprintf(" %-6s %16.8f  available %16.8f  pending %.8f", balance.Currency, balance.Balance, balance.Available, balance.Pending)})
+	}
+
+	return padLines(lines, height)
+}
+
+//padLines exactly height lines, cutting or padding with blanks.
+func padLines(lines []styledLine, height int) []styledLine {
+	if height < 0 {
+		height = 0
+	}
+
+	if len(lines) > height {
+		return lines[:height]
+	}
+
+	for len(lines) < height {
+		lines = append(lines, styledLine{})
+	}
+
+	return lines
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/gateway"
+)
+
+/*
+runGateway serve market data to local consumers until interrupted.  The account streams are only served with -account,
+since the gateway does no authentication of its own.
+*/
+func runGateway(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("gateway")
+	listen := flags.String("listen", "127.0.0.1:8080", "address to serve on")
+	account := flags.Bool("account", false, "serve the order and balance streams")
+	maxBooks := flags.Int("max-books", 50, "most order books followed at once")
+	markets := parseArgs(flags, args, 0, -1)
+
+	if *account && !haveCredentials {
+		return nil, errNoCredentials("gateway -account")
+	}
+
+	if connectErr := client.ConnectWebSocket(); connectErr != nil {
+		return nil, connectErr
+	}
+
+	g, gatewayErr := gateway.New(client, gateway.Config{
+		Markets:  markets,
+		MaxBooks: *maxBooks,
+		Account:  *account,
+	})
+	if gatewayErr != nil {
+		return nil, gatewayErr
+	}
+	defer g.Close()
+
+	server := &http.Server{Addr: *listen, Handler: g}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- server.ListenAndServe()
+	}()
+
+	fmt.Fprintf(os.Stderr, "gateway listening on %s\n", *listen)
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	socketErrors := client.SubscribeToWebsocketErrors()
+
+	for {
+		select {
+		case serveErr := <-served:
+			return nil, serveErr
+		case socketErr := <-socketErrors:
+			fmt.Fprintf(os.Stderr, "socket error: %s\n", socketErr.Error())
+		case bookErr := <-g.Errors():
+			fmt.Fprintf(os.Stderr, "book error: %s\n", bookErr.Error())
+		case <-interrupt:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			//streams only end when the gateway drops them, so close it before waiting on the server.
+			g.Close()
+			return nil, server.Shutdown(ctx)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+/*
+bittrex command line access to the account and markets.
+
+	bittrex [global flags] <command> [flags] [args]
+
+Credentials are read from BITTREX_API_KEY and BITTREX_API_SECRET, or from the config file (-config, BITTREX_CONFIG,
+or ~/.bittrex.json by default), a JSON object holding "key" and "secret".  The environment wins when both are set.
+Public commands need no credentials.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/technicalviking/bittrex2"
+)
+
+//command one subcommand.  run returns the result to print, or nil when it printed its own output.
+type command struct {
+	usage       string
+	description string
+	private     bool
+	run         func(client *bittrex.Client, args []string) (*result, error)
+}
+
+//commands filled in by init, since the commands' flag sets refer back to it for their usage.
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"markets":         {"[-base BTC]", "list markets", false, runMarkets},
+		"ticker":          {"MARKET...", "bid, ask and last price", false, runTicker},
+		"book":            {"[-depth 10] MARKET", "order book", false, runBook},
+		"history":         {"[-limit 50] MARKET", "recent trades", false, runHistory},
+		"candles":         {"[-interval hour] [-limit 50] MARKET", "candles; interval is oneMin, fiveMin, thirtyMin, hour or day", false, runCandles},
+		"balances":        {"[-all]", "account balances; zero balances are hidden without -all", true, runBalances},
+		"orders":          {"open|history [MARKET]", "open orders or order history", true, runOrders},
+		"buy":             {"[-condition GREATER_THAN -target RATE] [-yes] MARKET QUANTITY RATE", "place a limit buy", true, runBuy},
+		"sell":            {"[-condition LESS_THAN -target RATE] [-yes] MARKET QUANTITY RATE", "place a limit sell", true, runSell},
+		"cancel":          {"ORDER_UUID...", "cancel orders", true, runCancel},
+		"cancel-all":      {"[-yes] [MARKET]", "cancel every open order, in one market or all of them", true, runCancelAll},
+		"deposit-address": {"CURRENCY", "deposit address for a currency", true, runDepositAddress},
+		"withdraw":        {"[-payment-id ID] [-yes] CURRENCY QUANTITY ADDRESS", "withdraw to an address", true, runWithdraw},
+		"dashboard":       {"[MARKET]", "full-screen markets, order book, fills, open orders and balances", false, runDashboard},
+		"gateway":         {"[-listen ADDR] [-account] [-max-books N] [MARKET...]", "serve market data and cached public calls to local consumers", false, runGateway},
+		"watch":           {"summary|lite|exchange|orders|balances [MARKET...]", "stream socket deltas until interrupted; balances takes currencies", false, runWatch},
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: bittrex [-config FILE] [-output table|json|csv] <command> [flags] [args]\n\ncommands:\n")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-16s %s\n  %-16s   %s\n", name, commands[name].description, "", commands[name].usage)
+	}
+
+	fmt.Fprintf(os.Stderr, "\nglobal flags:\n")
+	flag.PrintDefaults()
+}
+
+var (
+	configPath   = flag.String("config", "", "credentials file (default $BITTREX_CONFIG or ~/.bittrex.json)")
+	outputFormat = flag.String("output", "table", "output format: table, json or csv; watch prints json as NDJSON")
+
+	//haveCredentials for commands, like watch, that only sometimes need them.
+	haveCredentials bool
+)
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := flag.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "bittrex: unknown command %q\n\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	out, outputErr := newOutput(*outputFormat)
+	if outputErr != nil {
+		fail(outputErr)
+	}
+
+	creds, credsErr := loadCredentials(*configPath)
+	if credsErr != nil {
+		fail(credsErr)
+	}
+
+	haveCredentials = creds.Key != "" && creds.Secret != ""
+
+	if cmd.private && !haveCredentials {
+		fail(errNoCredentials(name))
+	}
+
+	client, clientErr := bittrex.New(creds.Key, creds.Secret)
+	if clientErr != nil {
+		fail(clientErr)
+	}
+
+	res, runErr := cmd.run(client, flag.Args()[1:])
+	if runErr != nil {
+		fail(fmt.Errorf("%s: %s", name, runErr.Error()))
+	}
+
+	if res == nil {
+		return
+	}
+
+	if writeErr := out.write(os.Stdout, res); writeErr != nil {
+		fail(writeErr)
+	}
+}
+
+func errNoCredentials(name string) error {
+	return fmt.Errorf("%s needs credentials: set BITTREX_API_KEY and BITTREX_API_SECRET, or use a config file", name)
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "bittrex: %s\n", err.Error())
+	os.Exit(1)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/technicalviking/bittrex2"
+)
+
+//newFlags flag set for a subcommand.  Flags come before positional arguments.
+func newFlags(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: bittrex %s %s\n", name, commands[name].usage)
+		flags.PrintDefaults()
+	}
+
+	return flags
+}
+
+//parseArgs parse flags, then require between min and max positional arguments (max -1 for no limit).
+func parseArgs(flags *flag.FlagSet, args []string, min, max int) []string {
+	flags.SetOutput(ioutil.Discard)
+	if parseErr := flags.Parse(args); parseErr != nil {
+		flags.SetOutput(os.Stderr)
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	positional := flags.Args()
+	if len(positional) < min || (max >= 0 && len(positional) > max) {
+		flags.SetOutput(os.Stderr)
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	return positional
+}
+
+func parseDecimal(name, raw string) (float64, error) {
+	value, parseErr := strconv.ParseFloat(raw, 64)
+	if parseErr != nil {
+		return 0, fmt.Errorf("invalid %s %q", name, raw)
+	}
+
+	return value, nil
+}
+
+//confirm ask on stderr before doing something that can't be undone, unless yes was given.
+func confirm(yes bool, format string, args ...interface{}) error {
+	if yes {
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, format+" [y/N] ", args...)
+
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+
+	return fmt.Errorf("aborted")
+}
+
+func runMarkets(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("markets")
+	base := flags.String("base", "", "only markets quoted in this currency")
+	parseArgs(flags, args, 0, 0)
+
+	markets, marketsErr := client.PublicGetMarkets()
+	if marketsErr != nil {
+		return nil, marketsErr
+	}
+
+	res := &result{header: []string{"MARKET", "CURRENCY", "BASE", "MIN TRADE", "ACTIVE"}}
+	var listed []bittrex.MarketDescription
+
+	for _, market := range markets {
+		if *base != "" && !strings.EqualFold(*base, market.BaseCurrency) {
+			continue
+		}
+
+		listed = append(listed, market)
+		res.rows = append(res.rows, []string{
+			market.MarketName,
+			market.MarketCurrency,
+			market.BaseCurrency,
+			formatDecimal(market.MinTradeSize),
+			strconv.FormatBool(market.IsActive),
+		})
+	}
+
+	res.value = listed
+
+	return res, nil
+}
+
+func runTicker(client *bittrex.Client, args []string) (*result, error) {
+	markets := parseArgs(newFlags("ticker"), args, 1, -1)
+
+	res := &result{header: []string{"MARKET", "BID", "ASK", "LAST"}}
+	tickers := make(map[string]bittrex.Ticker)
+
+	for _, market := range markets {
+		market = strings.ToUpper(market)
+
+		ticker, tickerErr := client.PublicGetTicker(market)
+		if tickerErr != nil {
+			return nil, tickerErr
+		}
+
+		tickers[market] = ticker
+		res.rows = append(res.rows, []string{market, formatDecimal(ticker.Bid), formatDecimal(ticker.Ask), formatDecimal(ticker.Last)})
+	}
+
+	res.value = tickers
+
+	return res, nil
+}
+
+func runBook(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("book")
+	depth := flags.Int("depth", 10, "levels per side; zero for all of them")
+	positional := parseArgs(flags, args, 1, 1)
+
+	book, bookErr := client.PublicGetOrderBook(strings.ToUpper(positional[0]), "both")
+	if bookErr != nil {
+		return nil, bookErr
+	}
+
+	if *depth > 0 {
+		if len(book.Buy) > *depth {
+			book.Buy = book.Buy[:*depth]
+		}
+		if len(book.Sell) > *depth {
+			book.Sell = book.Sell[:*depth]
+		}
+	}
+
+	res := &result{value: book, header: []string{"SIDE", "RATE", "QUANTITY"}}
+
+	//asks highest first, so the spread sits in the middle of the table.
+	for i := len(book.Sell) - 1; i >= 0; i-- {
+		res.rows = append(res.rows, []string{"SELL", formatDecimal(book.Sell[i].Rate), formatDecimal(book.Sell[i].Quantity)})
+	}
+
+	for _, level := range book.Buy {
+		res.rows = append(res.rows, []string{"BUY", formatDecimal(level.Rate), formatDecimal(level.Quantity)})
+	}
+
+	return res, nil
+}
+
+func runHistory(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("history")
+	limit := flags.Int("limit", 50, "most recent trades to show; zero for all of them")
+	positional := parseArgs(flags, args, 1, 1)
+
+	trades, historyErr := client.PublicGetMarketHistory(strings.ToUpper(positional[0]))
+	if historyErr != nil {
+		return nil, historyErr
+	}
+
+	if *limit > 0 && len(trades) > *limit {
+		trades = trades[:*limit]
+	}
+
+	res := &result{value: trades, header: []string{"TIME", "SIDE", "PRICE", "QUANTITY", "TOTAL", "FILL"}}
+	for _, trade := range trades {
+		res.rows = append(res.rows, []string{
+			formatTime(trade.TimeStamp.Time()),
+			trade.OrderType,
+			formatDecimal(trade.Price),
+			formatDecimal(trade.Quantity),
+			formatDecimal(trade.Total),
+			trade.FillType,
+		})
+	}
+
+	return res, nil
+}
+
+func runCandles(client *bittrex.Client, args []string) (*result, error) {
+	flags := newFlags("candles")
+	interval := flags.String("interval", bittrex.TickIntervalHour, "oneMin, fiveMin, thirtyMin, hour or day")
+	limit := flags.Int("limit", 50, "most recent candles to show; zero for all of them")
+	positional := parseArgs(flags, args, 1, 1)
+
+	switch *interval {
+	case bittrex.TickIntervalOneMin, bittrex.TickIntervalFiveMin, bittrex.TickIntervalThirtyMin, bittrex.TickIntervalHour, bittrex.TickIntervalDay:
+	default:
+		return nil, fmt.Errorf("unknown interval %q", *interval)
+	}
+
+	candles, ticksErr := client.PubMarketGetTicks(strings.ToUpper(positional[0]), *interval)
+	if ticksErr != nil {
+		return nil, ticksErr
+	}
+
+	//oldest first, so the most recent are at the end.
+	if *limit > 0 && len(candles) > *limit {
+		candles = candles[len(candles)-*limit:]
+	}
+
+	res := &result{value: candles, header: []string{"TIME", "OPEN", "HIGH", "LOW", "CLOSE", "VOLUME", "BASE VOLUME"}}
+	for _, candle := range candles {
+		res.rows = append(res.rows, []string{
+			formatTime(candle.TimeStamp.Time()),
+			formatDecimal(candle.Open),
+			formatDecimal(candle.High),
+			formatDecimal(candle.Low),
+			formatDecimal(candle.Close),
+			formatDecimal(candle.Volume),
+			formatDecimal(candle.BaseVolume),
+		})
+	}
+
+	return res, nil
+}
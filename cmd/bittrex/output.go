@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+//result what a command prints: value as JSON, or header and rows as a table or CSV.
+type result struct {
+	value  interface{}
+	header []string
+	rows   [][]string
+}
+
+type output struct {
+	format string
+}
+
+func newOutput(format string) (*output, error) {
+	switch format {
+	case "table", "json", "csv":
+		return &output{format: format}, nil
+	}
+
+	return nil, fmt.Errorf("unknown output format %q, use table, json or csv", format)
+}
+
+func (o *output) write(w io.Writer, res *result) error {
+	switch o.format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(res.value)
+	case "csv":
+		writer := csv.NewWriter(w)
+		if writeErr := writer.Write(res.header); writeErr != nil {
+			return writeErr
+		}
+		if writeErr := writer.WriteAll(res.rows); writeErr != nil {
+			return writeErr
+		}
+		return writer.Error()
+	}
+
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(res.header, "\t"))
+	for _, row := range res.rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+func formatDecimal(value float64) string {
+	return strconv.FormatFloat(value, 'f', 8, 64)
+}
+
+func formatTime(at time.Time) string {
+	if at.IsZero() {
+		return ""
+	}
+
+	return at.UTC().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,19 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func makeRaw(fd int) (func(), error) {
+	return nil, fmt.Errorf("the dashboard needs a unix terminal")
+}
+
+func terminalSize(fd int) (int, int, error) {
+	return 0, 0, fmt.Errorf("the dashboard needs a unix terminal")
+}
+
+func notifyResize(ch chan os.Signal) {}
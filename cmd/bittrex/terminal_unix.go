@@ -0,0 +1,61 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+// +build linux darwin freebsd netbsd openbsd
+
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"unsafe"
+)
+
+func ioctl(fd int, request uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), request, uintptr(arg)); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+//makeRaw put the terminal in raw mode: no echo, no line buffering, no signals from ^C.  restore undoes it.
+func makeRaw(fd int) (func(), error) {
+	var original syscall.Termios
+	if getErr := ioctl(fd, ioctlGetTermios, unsafe.Pointer(&original)); getErr != nil {
+		return nil, getErr
+	}
+
+	raw := original
+	raw.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON
+	raw.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag &^= syscall.CSIZE | syscall.PARENB
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+
+	if setErr := ioctl(fd, ioctlSetTermios, unsafe.Pointer(&raw)); setErr != nil {
+		return nil, setErr
+	}
+
+	return func() {
+		ioctl(fd, ioctlSetTermios, unsafe.Pointer(&original))
+	}, nil
+}
+
+//terminalSize columns and rows.
+func terminalSize(fd int) (int, int, error) {
+	var size struct {
+		rows, cols, xpixel, ypixel uint16
+	}
+
+	if sizeErr := ioctl(fd, syscall.TIOCGWINSZ, unsafe.Pointer(&size)); sizeErr != nil {
+		return 0, 0, sizeErr
+	}
+
+	return int(size.cols), int(size.rows), nil
+}
+
+//notifyResize send on ch when the terminal is resized.
+func notifyResize(ch chan os.Signal) {
+	signal.Notify(ch, syscall.SIGWINCH)
+}
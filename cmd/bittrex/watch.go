@@ -0,0 +1,253 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/signalr"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//watchStatePoll how often the socket state is checked for reconnects.
+const watchStatePoll = 500 * time.Millisecond
+
+//watchEvent one line of watch output.  line is the human readable form; the rest is written as NDJSON.
+type watchEvent struct {
+	Type   string      `json:"type"`
+	Time   time.Time   `json:"time"`
+	Market string      `json:"market,omitempty"`
+	Data   interface{} `json:"data,omitempty"`
+	line   string
+}
+
+var orderDeltaNames = map[int]string{
+	socketPayloads.OrderDeltaOpen:    "OPEN",
+	socketPayloads.OrderDeltaPartial: "PARTIAL",
+	socketPayloads.OrderDeltaFill:    "FILL",
+	socketPayloads.OrderDeltaCancel:  "CANCEL",
+}
+
+/*
+runWatch connect the socket and print deltas until interrupted.  summary and lite with no markets watch every market.
+The socket reconnects on its own; a socket that gives up ends the command with an error, so a supervisor can restart it.
+*/
+func runWatch(client *bittrex.Client, args []string) (*result, error) {
+	positional := parseArgs(newFlags("watch"), args, 1, -1)
+	stream, filter := positional[0], positional[1:]
+
+	for i := range filter {
+		filter[i] = strings.ToUpper(filter[i])
+	}
+
+	switch stream {
+	case "summary", "lite", "exchange", "orders", "balances":
+	default:
+		return nil, fmt.Errorf("unknown stream %q, use summary, lite, exchange, orders or balances", stream)
+	}
+
+	if (stream == "orders" || stream == "balances") && !haveCredentials {
+		return nil, errNoCredentials(stream)
+	}
+
+	if stream == "exchange" && len(filter) == 0 {
+		return nil, fmt.Errorf("exchange needs at least one market")
+	}
+
+	ndjson := false
+	switch *outputFormat {
+	case "json":
+		ndjson = true
+	case "csv":
+		return nil, fmt.Errorf("output must be table or json")
+	}
+
+	if (stream == "summary" || stream == "lite") && len(filter) == 0 {
+		markets, marketsErr := client.PublicGetMarkets()
+		if marketsErr != nil {
+			return nil, marketsErr
+		}
+
+		for _, market := range markets {
+			if market.IsActive {
+				filter = append(filter, market.MarketName)
+			}
+		}
+	}
+
+	if connectErr := client.ConnectWebSocket(); connectErr != nil {
+		return nil, connectErr
+	}
+
+	events := make(chan watchEvent, 100)
+
+	if subscribeErr := subscribeWatch(client, stream, filter, events); subscribeErr != nil {
+		return nil, subscribeErr
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	ticker := time.NewTicker(watchStatePoll)
+	defer ticker.Stop()
+
+	socketErrors := client.SubscribeToWebsocketErrors()
+	state := client.GetWebSocketState()
+	emit := newWatchPrinter(ndjson)
+
+	emit(watchEvent{Type: "status", Data: state.String(), line: "socket " + state.String()})
+
+	for {
+		select {
+		case <-interrupt:
+			return nil, nil
+		case event := <-events:
+			emit(event)
+		case socketErr := <-socketErrors:
+			emit(watchEvent{Type: "error", Data: socketErr.Error(), line: "socket error: " + socketErr.Error()})
+		case <-ticker.C:
+			current := client.GetWebSocketState()
+			if current == state {
+				continue
+			}
+
+			state = current
+			emit(watchEvent{Type: "status", Data: state.String(), line: "socket " + state.String()})
+
+			if state == signalr.Disconnected {
+				return nil, fmt.Errorf("socket disconnected")
+			}
+		}
+	}
+}
+
+func newWatchPrinter(ndjson bool) func(watchEvent) {
+	encoder := json.NewEncoder(os.Stdout)
+
+	return func(event watchEvent) {
+		if event.Time.IsZero() {
+			event.Time = time.Now()
+		}
+
+		if ndjson {
+			encoder.Encode(event)
+			return
+		}
+
+		fmt.Printf("%s %s\n", event.Time.UTC().Format("15:04:05"), event.line)
+	}
+}
+
+//subscribeWatch subscribe to stream and forward its deltas as events.  filter is markets, or currencies for balances.
+func subscribeWatch(client *bittrex.Client, stream string, filter []string, events chan watchEvent) error {
+	wanted := make(map[string]bool, len(filter))
+	for _, name := range filter {
+		wanted[name] = true
+	}
+
+	switch stream {
+	case "summary":
+		for _, market := range filter {
+			deltas, subscribeErr := client.SubscribeToMarketSummary(market)
+			if subscribeErr != nil {
+				return fmt.Errorf("%s: %s", market, subscribeErr.Error())
+			}
+
+			go func(deltas chan socketPayloads.Summary) {
+				for delta := range deltas {
+					events <- watchEvent{
+						Type:   stream,
+						Market: delta.MarketName,
+						Data:   delta,
+						line: fmt.Sprintf("%-10s bid %s  ask %s  last %s  volume %s", delta.MarketName,
+							formatDecimal(delta.Bid), formatDecimal(delta.Ask), formatDecimal(delta.Last), formatDecimal(delta.BaseVolume)),
+					}
+				}
+			}(deltas)
+		}
+	case "lite":
+		for _, market := range filter {
+			deltas, subscribeErr := client.SubscribeToMarketSummaryLite(market)
+			if subscribeErr != nil {
+				return fmt.Errorf("%s: %s", market, subscribeErr.Error())
+			}
+
+			go func(deltas chan socketPayloads.SummaryLiteDelta) {
+				for delta := range deltas {
+					events <- watchEvent{
+						Type:   stream,
+						Market: delta.MarketName,
+						Data:   delta,
+						line:   fmt.Sprintf("%-10s last %s  volume %s", delta.MarketName, formatDecimal(delta.Last), formatDecimal(delta.BaseVolume)),
+					}
+				}
+			}(deltas)
+		}
+	case "exchange":
+		for _, market := range filter {
+			deltas, subscribeErr := client.SubscribeToExchange(market)
+			if subscribeErr != nil {
+				return fmt.Errorf("%s: %s", market, subscribeErr.Error())
+			}
+
+			go func(deltas chan socketPayloads.ExchangeDelta) {
+				for delta := range deltas {
+					events <- watchEvent{Type: stream, Market: delta.MarketName, Data: delta, line: exchangeLine(delta)}
+				}
+			}(deltas)
+		}
+	case "orders":
+		deltas := client.SubscribeToOrderChanges()
+
+		go func() {
+			for delta := range deltas {
+				order := delta.Order
+				if len(wanted) > 0 && !wanted[strings.ToUpper(order.Exchange)] {
+					continue
+				}
+
+				events <- watchEvent{
+					Type:   stream,
+					Market: order.Exchange,
+					Data:   delta,
+					line: fmt.Sprintf("%-10s %-7s %s %s remaining %s of %s at %s", order.Exchange, orderDeltaNames[delta.Type],
+						order.OrderType, order.OrderUUID, formatDecimal(order.QuantityRemaining), formatDecimal(order.Quantity), formatDecimal(order.Limit)),
+				}
+			}
+		}()
+	case "balances":
+		deltas := client.SubscribeToBalanceChanges()
+
+		go func() {
+			for delta := range deltas {
+				if len(wanted) > 0 && !wanted[strings.ToUpper(delta.Currency)] {
+					continue
+				}
+
+				events <- watchEvent{
+					Type: stream,
+					Data: delta,
+					line: fmt.Sprintf("%-6s balance %s  available %s  pending %s", delta.Currency,
+						formatDecimal(delta.Balance), formatDecimal(delta.Available), formatDecimal(delta.Pending)),
+				}
+			}
+		}()
+	}
+
+	return nil
+}
+
+//exchangeLine the fills in full, and a count of the book changes.
+func exchangeLine(delta socketPayloads.ExchangeDelta) string {
+	line := fmt.Sprintf("%-10s #%d  %d bid, %d ask changes", delta.MarketName, delta.Nonce, len(delta.Buys), len(delta.Sells))
+
+	for _, fill := range delta.Fills {
+		line += fmt.Sprintf("\n         %-10s %-4s %s at %s", delta.MarketName, fill.OrderType, formatDecimal(fill.Quantity), formatDecimal(fill.Rate))
+	}
+
+	return line
+}
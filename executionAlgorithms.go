@@ -0,0 +1,740 @@
+package bittrex
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+/*
+ExecutionConfig settings shared by every execution algorithm.
+Child orders are plain limit orders placed through MarketBuyLimit / MarketSellLimit, so an attached
+market registry and order normalizer apply to each of them.
+*/
+type ExecutionConfig struct {
+	Market   string
+	Side     OrderSide
+	Quantity decimal
+
+	//LimitPrice worst rate any child may be priced at.  zero for no limit.
+	LimitPrice decimal
+
+	//Aggressive price children against the opposite side of the book (taking liquidity) instead of joining the best price on our own side.
+	Aggressive bool
+
+	//MinChildSize no child is placed smaller than this.  Set it to the market's MinTradeSize.  An execution left with
+	//less than this to fill finishes with an error reporting the shortfall.
+	MinChildSize decimal
+
+	//OnProgress optional callback, called for every progress update in addition to the Progress channel.
+	OnProgress func(ExecutionProgress)
+}
+
+//TWAPConfig split the parent into equal slices spread evenly over Duration.
+type TWAPConfig struct {
+	ExecutionConfig
+	Duration time.Duration
+	Slices   int
+}
+
+//IcebergConfig show at most DisplayQuantity at a time, placing the next slice when the visible one fills.
+type IcebergConfig struct {
+	ExecutionConfig
+	DisplayQuantity decimal
+}
+
+//POVConfig trade Participation (a fraction, 0.1 = 10%) of the volume others have filled in the market since the start.
+type POVConfig struct {
+	ExecutionConfig
+	Participation decimal
+	MaxChildSize  decimal //zero for no maximum
+}
+
+//ExecutionProgress aggregate state of an algorithmic execution.
+type ExecutionProgress struct {
+	Algorithm    string
+	Market       string
+	Side         OrderSide
+	Quantity     decimal
+	Filled       decimal
+	Remaining    decimal
+	AveragePrice decimal
+	ChildOrders  int
+	LiveOrderID  string
+	Done         bool
+	Err          error
+	Updated      time.Time
+}
+
+type executionChild struct {
+	quantity decimal
+	filled   decimal
+	rate     decimal
+	notional decimal
+	closed   bool
+}
+
+/*
+Execution a running execution algorithm.
+Every exchange call is made from the execution's own goroutine, never while holding the lock Snapshot reads under,
+and never from the socket's.  If the order deltas back up, the execution reads its children back from the exchange.
+*/
+type Execution struct {
+	client    *Client
+	book      *LocalOrderBook
+	ownBook   bool
+	config    ExecutionConfig
+	algorithm string
+
+	//written only by the execution's goroutine, under the mutex so Snapshot can read them.
+	mutex      sync.Mutex
+	children   map[string]*executionChild
+	live       string
+	cancelling string //child cancelled by the execution whose closing delta has not arrived
+	filled     decimal
+	notional   decimal
+	childCount int
+	done       bool
+	err        error
+
+	afterClose func() //called once the cancelling child has closed.  belongs to the execution's goroutine.
+
+	volumeMutex  sync.Mutex
+	volume       decimal //filled in the market since the POV goroutine last took it
+	volumeSignal chan struct{}
+
+	progress  chan ExecutionProgress
+	deltas    chan socketPayloads.OrderResponse
+	overflow  chan struct{}
+	cancelled chan struct{}
+	quit      chan struct{}
+	finished  chan struct{}
+
+	listenerMutex   sync.Mutex
+	removeListeners []func()
+	cancelOnce      sync.Once
+}
+
+//StartTWAP begin a time weighted execution.  book may be nil, in which case one is created for the market.
+func StartTWAP(c *Client, book *LocalOrderBook, config TWAPConfig) (*Execution, error) {
+	if config.Slices <= 0 || config.Duration <= 0 {
+		return nil, OrderValidationError{"Slices", "TWAP requires a positive slice count and duration"}
+	}
+
+	e, startErr := newExecution(c, book, config.ExecutionConfig, "TWAP")
+	if startErr != nil {
+		return nil, startErr
+	}
+
+	go e.runTWAP(config)
+
+	return e, nil
+}
+
+//StartIceberg begin an iceberg execution.  book may be nil, in which case one is created for the market.
+func StartIceberg(c *Client, book *LocalOrderBook, config IcebergConfig) (*Execution, error) {
+	if config.DisplayQuantity <= 0 {
+		return nil, OrderValidationError{"DisplayQuantity", "must be greater than zero"}
+	}
+
+	if config.DisplayQuantity < config.MinChildSize {
+		return nil, OrderValidationError{"DisplayQuantity", "must be at least MinChildSize"}
+	}
+
+	e, startErr := newExecution(c, book, config.ExecutionConfig, "ICEBERG")
+	if startErr != nil {
+		return nil, startErr
+	}
+
+	go e.runIceberg(config)
+
+	return e, nil
+}
+
+//StartPOV begin a percentage of volume execution.  book may be nil, in which case one is created for the market.
+func StartPOV(c *Client, book *LocalOrderBook, config POVConfig) (*Execution, error) {
+	if config.Participation <= 0 || config.Participation > 1 {
+		return nil, OrderValidationError{"Participation", "must be a fraction above 0 and at most 1"}
+	}
+
+	e, startErr := newExecution(c, book, config.ExecutionConfig, "POV")
+	if startErr != nil {
+		return nil, startErr
+	}
+
+	removeFills, listenErr := c.addExchangeListener(config.Market, func(delta socketPayloads.ExchangeDelta) {
+		var total decimal
+		for _, fill := range delta.Fills {
+			total += fill.Quantity
+		}
+
+		if total == 0 {
+			return
+		}
+
+		//added up here rather than queued, so a busy execution never holds up the socket.
+		e.volumeMutex.Lock()
+		e.volume += total
+		e.volumeMutex.Unlock()
+
+		select {
+		case e.volumeSignal <- struct{}{}:
+		default:
+		}
+	})
+
+	if listenErr != nil {
+		e.finish(listenErr)
+		return nil, listenErr
+	}
+
+	e.addRemoveListener(removeFills)
+
+	go e.runPOV(config)
+
+	return e, nil
+}
+
+func newExecution(c *Client, book *LocalOrderBook, config ExecutionConfig, algorithm string) (*Execution, error) {
+	if config.Side != OrderSideBuy && config.Side != OrderSideSell {
+		return nil, OrderValidationError{"Side", fmt.Sprintf("unknown side %q", config.Side)}
+	}
+
+	if config.Quantity <= 0 {
+		return nil, OrderValidationError{"Quantity", "must be greater than zero"}
+	}
+
+	e := &Execution{
+		client:       c,
+		book:         book,
+		config:       config,
+		algorithm:    algorithm,
+		children:     make(map[string]*executionChild),
+		volumeSignal: make(chan struct{}, 1),
+		progress:     make(chan ExecutionProgress, 100),
+		deltas:       make(chan socketPayloads.OrderResponse, 100),
+		overflow:     make(chan struct{}, 1),
+		cancelled:    make(chan struct{}),
+		quit:         make(chan struct{}),
+		finished:     make(chan struct{}),
+	}
+
+	if e.book == nil {
+		newBook, bookErr := NewLocalOrderBook(c, config.Market)
+		if bookErr != nil {
+			return nil, bookErr
+		}
+
+		e.book = newBook
+		e.ownBook = true
+	}
+
+	e.addRemoveListener(c.addOrderListener(func(order socketPayloads.OrderResponse) {
+		select {
+		case e.deltas <- order:
+		default:
+			//the execution is behind; it reads its children back from the exchange rather than stall the socket.
+			e.signalOverflow()
+		}
+	}))
+
+	return e, nil
+}
+
+//Progress progress updates.  Buffered; updates are dropped if it fills.  Use Wait for the final state.
+func (e *Execution) Progress() chan ExecutionProgress {
+	return e.progress
+}
+
+//Snapshot current progress.
+func (e *Execution) Snapshot() ExecutionProgress {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	return e.snapshotLocked()
+}
+
+//Wait block until the execution is finished, returning its final state.
+func (e *Execution) Wait() ExecutionProgress {
+	<-e.finished
+	return e.Snapshot()
+}
+
+//Cancel stop the execution, cancelling the live child order.  Returns at once; Wait for the final state.
+func (e *Execution) Cancel() {
+	e.cancelOnce.Do(func() {
+		close(e.cancelled)
+	})
+}
+
+func (e *Execution) runTWAP(config TWAPConfig) {
+	interval := config.Duration / time.Duration(config.Slices)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	slice := 0
+	e.twapSlice(config, &slice)
+
+	for {
+		select {
+		case <-e.quit:
+			return
+		case <-e.cancelled:
+			e.finish(fmt.Errorf("%s execution cancelled", e.algorithm))
+		case delta := <-e.deltas:
+			e.handleDelta(delta.Type, delta.Order.OrderUUID, delta.Order.Quantity, delta.Order.QuantityRemaining, delta.Order.PricePerUnit, nil)
+		case <-e.overflow:
+			e.readBack(nil)
+		case <-ticker.C:
+			e.twapSlice(config, &slice)
+		}
+	}
+}
+
+func (e *Execution) twapSlice(config TWAPConfig, slice *int) {
+	if e.done {
+		return
+	}
+
+	if e.live != "" {
+		//what is left to slice is only known once the cancelled child's last fills are in.
+		e.afterClose = func() { e.twapPlace(config, slice) }
+		e.cancelLive()
+		return
+	}
+
+	if !e.settleCancel() {
+		return
+	}
+
+	e.afterClose = nil
+	e.twapPlace(config, slice)
+}
+
+func (e *Execution) twapPlace(config TWAPConfig, slice *int) {
+	remaining := e.remaining()
+
+	if *slice >= config.Slices || remaining <= quantityEpsilon {
+		//the last slice has had its interval to fill.
+		e.finish(nil)
+		return
+	}
+
+	slicesLeft := config.Slices - *slice
+	*slice++
+
+	//a slice below the minimum is made up to it, leaving less for the slices after.
+	quantity := math.Min(math.Max(remaining/decimal(slicesLeft), e.config.MinChildSize), remaining)
+
+	if placeErr := e.placeChild(quantity); placeErr != nil {
+		e.finish(placeErr)
+	}
+}
+
+func (e *Execution) runIceberg(config IcebergConfig) {
+	reload := func() {
+		remaining := e.remaining()
+		if remaining <= quantityEpsilon {
+			e.finish(nil)
+			return
+		}
+
+		if placeErr := e.placeChild(math.Min(config.DisplayQuantity, remaining)); placeErr != nil {
+			e.finish(placeErr)
+		}
+	}
+
+	reload()
+
+	for {
+		select {
+		case <-e.quit:
+			return
+		case <-e.cancelled:
+			e.finish(fmt.Errorf("%s execution cancelled", e.algorithm))
+		case delta := <-e.deltas:
+			e.handleDelta(delta.Type, delta.Order.OrderUUID, delta.Order.Quantity, delta.Order.QuantityRemaining, delta.Order.PricePerUnit, reload)
+		case <-e.overflow:
+			e.readBack(reload)
+		}
+	}
+}
+
+func (e *Execution) runPOV(config POVConfig) {
+	//every fill in the market, the execution's own included.
+	var marketVolume decimal
+
+	place := func() {
+		remaining := e.remaining()
+		if remaining <= quantityEpsilon {
+			e.finish(nil)
+			return
+		}
+
+		if e.live != "" {
+			return
+		}
+
+		if remaining < e.config.MinChildSize {
+			//no amount of volume makes what is left placeable.
+			e.finish(e.shortfallErr())
+			return
+		}
+
+		//the execution's own fills are in marketVolume too; counting them would let it chase itself.
+		othersVolume := math.Max(marketVolume-e.filled, 0)
+		target := math.Min(config.Participation*othersVolume, e.config.Quantity)
+		deficit := math.Min(target-e.filled, remaining)
+
+		if config.MaxChildSize > 0 {
+			deficit = math.Min(deficit, config.MaxChildSize)
+		}
+
+		if deficit > 0 && deficit >= e.config.MinChildSize {
+			if placeErr := e.placeChild(deficit); placeErr != nil {
+				e.finish(placeErr)
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-e.quit:
+			return
+		case <-e.cancelled:
+			e.finish(fmt.Errorf("%s execution cancelled", e.algorithm))
+		case delta := <-e.deltas:
+			e.handleDelta(delta.Type, delta.Order.OrderUUID, delta.Order.Quantity, delta.Order.QuantityRemaining, delta.Order.PricePerUnit, place)
+		case <-e.overflow:
+			e.readBack(place)
+		case <-e.volumeSignal:
+			e.volumeMutex.Lock()
+			marketVolume += e.volume
+			e.volume = 0
+			e.volumeMutex.Unlock()
+
+			if !e.done {
+				place()
+			}
+		}
+	}
+}
+
+//handleDelta update fills from an order delta, or its read back.  onClosed is called when the live child closes.
+func (e *Execution) handleDelta(deltaType int, orderID string, quantity, remaining, price decimal, onClosed func()) {
+	e.mutex.Lock()
+
+	child, ok := e.children[orderID]
+	if !ok {
+		e.mutex.Unlock()
+		return
+	}
+
+	e.recordFillLocked(child, quantity-remaining, price)
+
+	if e.done {
+		//a fill that raced the final cancel.  counted, but nothing more is placed.
+		e.mutex.Unlock()
+		return
+	}
+
+	closing := deltaType == socketPayloads.OrderDeltaFill || deltaType == socketPayloads.OrderDeltaCancel
+
+	if closing && e.cancelling == orderID {
+		child.closed = true
+		e.cancelling = ""
+		e.emitLocked()
+		e.mutex.Unlock()
+
+		if next := e.afterClose; next != nil {
+			e.afterClose = nil
+			next()
+		}
+		return
+	}
+
+	wasLive := e.live == orderID
+
+	if closing {
+		child.closed = true
+
+		if wasLive {
+			e.live = ""
+		}
+	}
+
+	e.emitLocked()
+	e.mutex.Unlock()
+
+	if !wasLive || !child.closed {
+		return
+	}
+
+	switch {
+	case deltaType == socketPayloads.OrderDeltaCancel:
+		e.finish(fmt.Errorf("%s child order %s was cancelled outside the execution", e.algorithm, orderID))
+	case e.remaining() <= quantityEpsilon:
+		e.finish(nil)
+	case onClosed != nil:
+		onClosed()
+	}
+}
+
+//readBack handle the live and cancelling children's state from account/getorder as if it had arrived as deltas.
+func (e *Execution) readBack(onClosed func()) {
+	for _, orderID := range []string{e.live, e.cancelling} {
+		if orderID == "" || e.done {
+			continue
+		}
+
+		order, getErr := e.client.AccountGetOrder(orderID)
+		if getErr != nil {
+			//a missed close would leave the execution waiting for good, so it is read back again shortly.
+			time.AfterFunc(time.Second, e.signalOverflow)
+			continue
+		}
+
+		deltaType := socketPayloads.OrderDeltaOpen
+		switch {
+		case !order.IsOpen && order.QuantityRemaining <= quantityEpsilon:
+			deltaType = socketPayloads.OrderDeltaFill
+		case !order.IsOpen:
+			deltaType = socketPayloads.OrderDeltaCancel
+		case order.QuantityRemaining < order.Quantity:
+			deltaType = socketPayloads.OrderDeltaPartial
+		}
+
+		e.handleDelta(deltaType, orderID, order.Quantity, order.QuantityRemaining, order.PricePerUnit, onClosed)
+	}
+}
+
+func (e *Execution) signalOverflow() {
+	select {
+	case e.overflow <- struct{}{}:
+	default:
+	}
+}
+
+//recordFillLocked count a child's fills.  filled and price are the child's cumulative quantity and average price.
+func (e *Execution) recordFillLocked(child *executionChild, filled decimal, price decimal) {
+	if filled <= child.filled {
+		return
+	}
+
+	if price == 0 {
+		price = child.rate
+	}
+
+	//PricePerUnit is the average over the whole child, so its notional is recomputed rather than accumulated.
+	e.filled += filled - child.filled
+	e.notional += filled*price - child.notional
+	child.filled = filled
+	child.notional = filled * price
+}
+
+//price choose a child rate from the local book.
+func (e *Execution) price() (decimal, error) {
+	buy := e.config.Side == OrderSideBuy
+
+	var level OrderElement
+	var ok bool
+
+	switch {
+	case buy && e.config.Aggressive, !buy && !e.config.Aggressive:
+		level, ok = e.book.BestAsk()
+	default:
+		level, ok = e.book.BestBid()
+	}
+
+	if !ok {
+		if e.config.LimitPrice > 0 {
+			return e.config.LimitPrice, nil
+		}
+
+		return 0, fmt.Errorf("%s execution: order book for %s is empty and no limit price is set", e.algorithm, e.config.Market)
+	}
+
+	rate := level.Rate
+
+	if e.config.LimitPrice > 0 {
+		if buy {
+			rate = math.Min(rate, e.config.LimitPrice)
+		} else {
+			rate = math.Max(rate, e.config.LimitPrice)
+		}
+	}
+
+	return rate, nil
+}
+
+//placeChild place a child and make it the live one.  Deltas for it wait in the queue until it is recorded.
+func (e *Execution) placeChild(quantity decimal) error {
+	if quantity <= 0 || quantity < e.config.MinChildSize {
+		return e.shortfallErr()
+	}
+
+	rate, priceErr := e.price()
+	if priceErr != nil {
+		return priceErr
+	}
+
+	var tx TransactionID
+	var placeErr error
+
+	if e.config.Side == OrderSideBuy {
+		tx, placeErr = e.client.MarketBuyLimit(e.config.Market, quantity, rate)
+	} else {
+		tx, placeErr = e.client.MarketSellLimit(e.config.Market, quantity, rate)
+	}
+
+	if placeErr != nil {
+		return placeErr
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.children[tx.UUID] = &executionChild{quantity: quantity, rate: rate}
+	e.live = tx.UUID
+	e.childCount++
+
+	e.emitLocked()
+
+	return nil
+}
+
+func (e *Execution) cancelLive() {
+	live := e.live
+	if live == "" {
+		return
+	}
+
+	e.mutex.Lock()
+	e.cancelling = live
+	e.live = ""
+	e.mutex.Unlock()
+
+	//fills racing the cancel still arrive as deltas and are counted.
+	e.client.MarketCancel(live)
+}
+
+//settleCancel read back a cancelled child whose closing delta is late, counting its last fills.  true once closed.
+func (e *Execution) settleCancel() bool {
+	if e.cancelling == "" {
+		return true
+	}
+
+	order, getErr := e.client.AccountGetOrder(e.cancelling)
+	if getErr != nil {
+		return false
+	}
+
+	if order.IsOpen {
+		//the cancel may have failed; it is sent again, and settled on a later slice.
+		e.client.MarketCancel(e.cancelling)
+		return false
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	child := e.children[e.cancelling]
+	e.recordFillLocked(child, order.Quantity-order.QuantityRemaining, order.PricePerUnit)
+	child.closed = true
+	e.cancelling = ""
+
+	return true
+}
+
+//shortfallErr why an execution stopped with less left than it may place.
+func (e *Execution) shortfallErr() error {
+	return fmt.Errorf("%s execution: %.8f left to fill is below the minimum child size of %.8f", e.algorithm, e.remaining(), e.config.MinChildSize)
+}
+
+func (e *Execution) remaining() decimal {
+	return e.config.Quantity - e.filled
+}
+
+//finish end the execution, cancelling the live child.  Called from the execution's goroutine, or before it starts.
+func (e *Execution) finish(err error) {
+	if e.done {
+		return
+	}
+
+	live := e.live
+
+	e.mutex.Lock()
+	e.done = true
+	e.err = err
+	e.live = ""
+	e.mutex.Unlock()
+
+	if live != "" {
+		e.client.MarketCancel(live)
+	}
+
+	close(e.quit)
+
+	//listener removal waits on the pipes, so it happens off this goroutine.
+	go func() {
+		e.listenerMutex.Lock()
+		for _, remove := range e.removeListeners {
+			remove()
+		}
+		e.listenerMutex.Unlock()
+
+		if e.ownBook {
+			e.book.Close()
+		}
+	}()
+
+	e.mutex.Lock()
+	e.emitLocked()
+	e.mutex.Unlock()
+
+	close(e.finished)
+}
+
+func (e *Execution) addRemoveListener(remove func()) {
+	e.listenerMutex.Lock()
+	e.removeListeners = append(e.removeListeners, remove)
+	e.listenerMutex.Unlock()
+}
+
+func (e *Execution) snapshotLocked() ExecutionProgress {
+	progress := ExecutionProgress{
+		Algorithm:   e.algorithm,
+		Market:      e.config.Market,
+		Side:        e.config.Side,
+		Quantity:    e.config.Quantity,
+		Filled:      e.filled,
+		Remaining:   e.config.Quantity - e.filled,
+		ChildOrders: e.childCount,
+		LiveOrderID: e.live,
+		Done:        e.done,
+		Err:         e.err,
+		Updated:     time.Now(),
+	}
+
+	if e.filled > 0 {
+		progress.AveragePrice = e.notional / e.filled
+	}
+
+	return progress
+}
+
+func (e *Execution) emitLocked() {
+	progress := e.snapshotLocked()
+
+	if e.config.OnProgress != nil {
+		e.config.OnProgress(progress)
+	}
+
+	select {
+	case e.progress <- progress:
+	default:
+	}
+}
@@ -0,0 +1,599 @@
+/*
+Package gateway shares one bittrex Client, and its one socket connection, between any number of local consumers.
+
+The gateway keeps every market summary and the order books asked for current from the socket, and serves them as
+JSON snapshots, as Server-Sent Events, or over a WebSocket.  Public REST calls are proxied through a cache so
+consumers polling the same endpoint cost one upstream request per staleness window.
+
+	GET /health                          socket state, books, consumers
+	GET /summaries                       every market summary
+	GET /summaries/MARKET                one market summary
+	GET /books/MARKET?depth=N            order book snapshot; the book is followed until it sits idle for BookIdle
+	GET /stream/summaries?markets=A,B    summary deltas; all markets without markets
+	GET /stream/books/MARKET             book snapshots, at most one per BookInterval
+	GET /stream/orders                   order deltas; Config.Account only
+	GET /stream/balances                 balance deltas; Config.Account only
+	GET /api/v1.1/public/METHOD          cached public REST call, in the Bittrex response envelope
+
+A stream is a WebSocket when the request asks for an upgrade, and Server-Sent Events otherwise.  Each message is a
+JSON event: {"type": "summary", "market": "BTC-ETH", "data": {...}}.  A consumer that falls StreamBuffer events
+behind is disconnected rather than allowed to hold up the others.
+
+The gateway does no authentication of its own.  Bind it to localhost, or put it behind something that does,
+especially with Config.Account set.
+*/
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//Config arguments for New.
+type Config struct {
+	//Markets order books followed from the start.  Others are followed once a consumer asks for them.
+	Markets []string
+	//MaxBooks limit on the order books followed, so consumers can't subscribe the socket to everything.  defaults to 50.
+	MaxBooks int
+	//BookIdle how long a book nobody has asked for or streamed is followed before it is dropped, freeing its slot.
+	//Books in Markets are never dropped.  defaults to 5 minutes.
+	BookIdle time.Duration
+	//BookInterval minimum time between book snapshots on a stream.  defaults to 250ms.
+	BookInterval time.Duration
+	//StreamDepth levels per side in streamed book snapshots.  defaults to 25.
+	StreamDepth int
+	//StreamBuffer events queued per consumer before it is disconnected.  defaults to 256.
+	StreamBuffer int
+	//Account serve the order and balance streams.  The client must have been created with credentials.
+	Account bool
+	//MaxAge how stale a proxied response may be, by method name, e.g. "getmarkets".  see DefaultMaxAge.
+	MaxAge map[string]time.Duration
+}
+
+//DefaultMaxAge used for any proxied method missing from Config.MaxAge.
+var DefaultMaxAge = map[string]time.Duration{
+	"getmarkets":         5 * time.Minute,
+	"getcurrencies":      5 * time.Minute,
+	"getmarketsummaries": 10 * time.Second,
+	"getmarketsummary":   10 * time.Second,
+	"getticker":          5 * time.Second,
+	"getorderbook":       2 * time.Second,
+	"getmarkethistory":   5 * time.Second,
+}
+
+//Event one message on a stream.
+type Event struct {
+	Type   string      `json:"type"`
+	Market string      `json:"market,omitempty"`
+	Data   interface{} `json:"data"`
+}
+
+//Event Types
+const (
+	EventSummary = "summary"
+	EventBook    = "book"
+	EventOrder   = "order"
+	EventBalance = "balance"
+	EventError   = "error"
+)
+
+//BookSnapshot one side-by-side view of a followed book.
+type BookSnapshot struct {
+	Market string                 `json:"market"`
+	Nonce  int                    `json:"nonce"`
+	Synced bool                   `json:"synced"`
+	Buy    []bittrex.OrderElement `json:"buy"`
+	Sell   []bittrex.OrderElement `json:"sell"`
+}
+
+//Gateway an http.Handler serving the client's market data to local consumers.
+type Gateway struct {
+	client *bittrex.Client
+	config Config
+	mux    *http.ServeMux
+
+	summaryMutex sync.RWMutex
+	summaries    map[string]socketPayloads.Summary
+
+	//bookMutex guards books and the use counts in them.  It is never held while a book is loaded over REST.
+	bookMutex  sync.Mutex
+	books      map[string]*followedBook
+	booksEnded bool
+
+	hub   *hub
+	cache *cache
+
+	removeListeners []func()
+	errChan         chan error
+	quit            chan struct{}
+}
+
+type followedBook struct {
+	book         *bittrex.LocalOrderBook
+	removeUpdate func()
+	dirty        chan struct{}
+	done         chan struct{}
+
+	//ready closed once book is loaded, or loadErr set.
+	ready   chan struct{}
+	loadErr error
+
+	pinned   bool      //from Config.Markets
+	streams  int       //consumers using the book
+	lastUsed time.Time //last request for the book, or end of its last stream
+}
+
+/*
+New start serving client's market data.  The client's socket must already be connected, and authenticated for
+Config.Account.  Summaries are loaded from QuerySummaryState before New returns.
+*/
+func New(client *bittrex.Client, config Config) (*Gateway, error) {
+	if config.MaxBooks <= 0 {
+		config.MaxBooks = 50
+	}
+
+	if config.BookInterval <= 0 {
+		config.BookInterval = 250 * time.Millisecond
+	}
+
+	if config.StreamDepth <= 0 {
+		config.StreamDepth = 25
+	}
+
+	if config.StreamBuffer <= 0 {
+		config.StreamBuffer = 256
+	}
+
+	if config.BookIdle <= 0 {
+		config.BookIdle = 5 * time.Minute
+	}
+
+	g := &Gateway{
+		client:    client,
+		config:    config,
+		mux:       http.NewServeMux(),
+		summaries: make(map[string]socketPayloads.Summary),
+		books:     make(map[string]*followedBook),
+		hub:       newHub(),
+		cache:     newCache(),
+		errChan:   make(chan error, 5),
+		quit:      make(chan struct{}),
+	}
+
+	removeSummary, listenErr := client.OnSummaryDelta(g.summaryDelta)
+	if listenErr != nil {
+		return nil, fmt.Errorf("gateway - listen for summaries: %s", listenErr.Error())
+	}
+	g.removeListeners = append(g.removeListeners, removeSummary)
+
+	state, stateErr := client.QuerySummaryState()
+	if stateErr != nil {
+		g.Close()
+		return nil, fmt.Errorf("gateway - query summary state: %s", stateErr.Error())
+	}
+
+	g.summaryMutex.Lock()
+	for _, summary := range state.Summaries {
+		//deltas that arrived while the state was loading are newer.
+		if _, ok := g.summaries[summary.MarketName]; !ok {
+			g.summaries[summary.MarketName] = summary
+		}
+	}
+	g.summaryMutex.Unlock()
+
+	if config.Account {
+		g.removeListeners = append(g.removeListeners,
+			client.OnOrderDelta(func(order socketPayloads.OrderResponse) {
+				g.hub.publish(Event{Type: EventOrder, Market: order.Order.Exchange, Data: order})
+			}),
+			client.OnBalanceDelta(func(balance socketPayloads.Balance) {
+				g.hub.publish(Event{Type: EventBalance, Market: balance.BalanceDelta.Currency, Data: balance.BalanceDelta})
+			}),
+		)
+	}
+
+	for _, market := range config.Markets {
+		followed, bookErr := g.followBook(market)
+		if bookErr != nil {
+			g.Close()
+			return nil, bookErr
+		}
+
+		g.bookMutex.Lock()
+		followed.pinned = true
+		followed.streams--
+		g.bookMutex.Unlock()
+	}
+
+	go g.dropIdleBooks()
+
+	g.mux.HandleFunc("/health", g.handleHealth)
+	g.mux.HandleFunc("/summaries", g.handleSummaries)
+	g.mux.HandleFunc("/summaries/", g.handleSummary)
+	g.mux.HandleFunc("/books/", g.handleBook)
+	g.mux.HandleFunc("/stream/summaries", g.handleSummaryStream)
+	g.mux.HandleFunc("/stream/books/", g.handleBookStream)
+	g.mux.HandleFunc("/stream/orders", g.accountStream(EventOrder))
+	g.mux.HandleFunc("/stream/balances", g.accountStream(EventBalance))
+	g.mux.HandleFunc("/api/v1.1/public/", g.handleProxy)
+
+	return g, nil
+}
+
+//ServeHTTP implement http.Handler
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+//Errors book resynchronisations that failed.  Buffered; errors are dropped if it fills.
+func (g *Gateway) Errors() chan error {
+	return g.errChan
+}
+
+//Close stop following the socket and disconnect every consumer.  The client is left connected.
+func (g *Gateway) Close() {
+	for _, remove := range g.removeListeners {
+		remove()
+	}
+	g.removeListeners = nil
+
+	g.bookMutex.Lock()
+	if !g.booksEnded {
+		g.booksEnded = true
+		close(g.quit)
+	}
+
+	for market, followed := range g.books {
+		g.unfollowLocked(market, followed)
+	}
+	g.bookMutex.Unlock()
+
+	g.hub.closeAll()
+}
+
+func (g *Gateway) summaryDelta(summary socketPayloads.Summary) {
+	g.summaryMutex.Lock()
+	g.summaries[summary.MarketName] = summary
+	g.summaryMutex.Unlock()
+
+	g.hub.publish(Event{Type: EventSummary, Market: summary.MarketName, Data: summary})
+}
+
+/*
+followBook the followed book for market, following it now if it isn't yet.  When MaxBooks are followed, the book idle
+longest makes way.  A book being loaded holds its slot, and later requests for it wait for the load.  The book is
+returned in use, so it can't be dropped before the caller is done with it; the caller must releaseBook it.
+*/
+func (g *Gateway) followBook(market string) (*followedBook, error) {
+	market = strings.ToUpper(market)
+
+	g.bookMutex.Lock()
+
+	if g.booksEnded {
+		g.bookMutex.Unlock()
+		return nil, fmt.Errorf("gateway - closed")
+	}
+
+	if followed, ok := g.books[market]; ok {
+		followed.lastUsed = time.Now()
+		followed.streams++
+		g.bookMutex.Unlock()
+
+		<-followed.ready
+		if followed.loadErr != nil {
+			g.releaseBook(followed)
+			return nil, followed.loadErr
+		}
+
+		return followed, nil
+	}
+
+	if len(g.books) >= g.config.MaxBooks && !g.dropOldestIdleLocked() {
+		g.bookMutex.Unlock()
+		return nil, fmt.Errorf("gateway - already following %d books, none idle", len(g.books))
+	}
+
+	followed := &followedBook{
+		dirty:    make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		ready:    make(chan struct{}),
+		streams:  1,
+		lastUsed: time.Now(),
+	}
+	g.books[market] = followed
+	g.bookMutex.Unlock()
+
+	book, bookErr := bittrex.NewLocalOrderBook(g.client, market)
+
+	g.bookMutex.Lock()
+	defer g.bookMutex.Unlock()
+	defer close(followed.ready)
+
+	if bookErr != nil {
+		followed.loadErr = fmt.Errorf("gateway - follow %s: %s", market, bookErr.Error())
+		if g.books[market] == followed {
+			delete(g.books, market)
+		}
+
+		return nil, followed.loadErr
+	}
+
+	if g.booksEnded {
+		book.Close()
+		followed.loadErr = fmt.Errorf("gateway - closed")
+		if g.books[market] == followed {
+			delete(g.books, market)
+		}
+
+		return nil, followed.loadErr
+	}
+
+	followed.book = book
+	followed.removeUpdate = book.OnUpdate(func() {
+		select {
+		case followed.dirty <- struct{}{}:
+		default:
+		}
+	})
+
+	go g.publishBook(followed)
+
+	return followed, nil
+}
+
+//releaseBook a consumer is done with the book.
+func (g *Gateway) releaseBook(followed *followedBook) {
+	g.bookMutex.Lock()
+	defer g.bookMutex.Unlock()
+
+	followed.streams--
+	followed.lastUsed = time.Now()
+}
+
+//dropIdleBooks unfollow books idle for BookIdle, until the gateway is closed.
+func (g *Gateway) dropIdleBooks() {
+	interval := g.config.BookIdle / 2
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.quit:
+			return
+		case now := <-ticker.C:
+			g.bookMutex.Lock()
+			for market, followed := range g.books {
+				if g.idleLocked(followed) && now.Sub(followed.lastUsed) >= g.config.BookIdle {
+					g.unfollowLocked(market, followed)
+				}
+			}
+			g.bookMutex.Unlock()
+		}
+	}
+}
+
+//dropOldestIdleLocked unfollow the book idle longest, if any is idle.  must be called with bookMutex held.
+func (g *Gateway) dropOldestIdleLocked() bool {
+	var oldest string
+	for market, followed := range g.books {
+		if g.idleLocked(followed) && (oldest == "" || followed.lastUsed.Before(g.books[oldest].lastUsed)) {
+			oldest = market
+		}
+	}
+
+	if oldest == "" {
+		return false
+	}
+
+	g.unfollowLocked(oldest, g.books[oldest])
+	return true
+}
+
+//idleLocked whether the book may be dropped: loaded, not pinned and not streamed.  must be called with bookMutex held.
+func (g *Gateway) idleLocked(followed *followedBook) bool {
+	return followed.book != nil && !followed.pinned && followed.streams == 0
+}
+
+//unfollowLocked stop following a book.  A book still loading is left to followBook.  must be called with bookMutex held.
+func (g *Gateway) unfollowLocked(market string, followed *followedBook) {
+	if followed.book == nil {
+		return
+	}
+
+	followed.removeUpdate()
+	followed.book.Close()
+	close(followed.done)
+	delete(g.books, market)
+}
+
+//publishBook stream a snapshot after each change, no more often than BookInterval.
+func (g *Gateway) publishBook(followed *followedBook) {
+	for {
+		select {
+		case <-followed.done:
+			return
+		case syncErr := <-followed.book.Errors():
+			select {
+			case g.errChan <- syncErr:
+			default:
+			}
+			g.hub.publish(Event{Type: EventError, Market: followed.book.Market(), Data: syncErr.Error()})
+		case <-followed.dirty:
+			g.hub.publish(Event{Type: EventBook, Market: followed.book.Market(), Data: snapshot(followed.book, g.config.StreamDepth)})
+
+			select {
+			case <-followed.done:
+				return
+			case <-time.After(g.config.BookInterval):
+			}
+		}
+	}
+}
+
+func snapshot(book *bittrex.LocalOrderBook, depth int) BookSnapshot {
+	levels := book.Snapshot(depth)
+
+	return BookSnapshot{
+		Market: book.Market(),
+		Nonce:  book.Nonce(),
+		Synced: book.Synced(),
+		Buy:    levels.Buy,
+		Sell:   levels.Sell,
+	}
+}
+
+func (g *Gateway) handleHealth(w http.ResponseWriter, r *http.Request) {
+	g.bookMutex.Lock()
+	books := make([]string, 0, len(g.books))
+	for market := range g.books {
+		books = append(books, market)
+	}
+	g.bookMutex.Unlock()
+	sort.Strings(books)
+
+	g.summaryMutex.RLock()
+	summaries := len(g.summaries)
+	g.summaryMutex.RUnlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"socket":    g.client.GetWebSocketState().String(),
+		"books":     books,
+		"summaries": summaries,
+		"consumers": g.hub.count(),
+	})
+}
+
+func (g *Gateway) handleSummaries(w http.ResponseWriter, r *http.Request) {
+	g.summaryMutex.RLock()
+	summaries := make([]socketPayloads.Summary, 0, len(g.summaries))
+	for _, summary := range g.summaries {
+		summaries = append(summaries, summary)
+	}
+	g.summaryMutex.RUnlock()
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].MarketName < summaries[j].MarketName
+	})
+
+	writeJSON(w, http.StatusOK, summaries)
+}
+
+func (g *Gateway) handleSummary(w http.ResponseWriter, r *http.Request) {
+	market := strings.ToUpper(strings.TrimPrefix(r.URL.Path, "/summaries/"))
+
+	g.summaryMutex.RLock()
+	summary, ok := g.summaries[market]
+	g.summaryMutex.RUnlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no summary for %q", market))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, summary)
+}
+
+func (g *Gateway) handleBook(w http.ResponseWriter, r *http.Request) {
+	market := strings.TrimPrefix(r.URL.Path, "/books/")
+
+	depth, depthErr := queryInt(r, "depth")
+	if depthErr != nil {
+		writeError(w, http.StatusBadRequest, depthErr)
+		return
+	}
+
+	followed, bookErr := g.followBook(market)
+	if bookErr != nil {
+		writeError(w, http.StatusBadGateway, bookErr)
+		return
+	}
+	defer g.releaseBook(followed)
+
+	writeJSON(w, http.StatusOK, snapshot(followed.book, depth))
+}
+
+func (g *Gateway) handleSummaryStream(w http.ResponseWriter, r *http.Request) {
+	markets := make(map[string]bool)
+	for _, market := range strings.Split(r.URL.Query().Get("markets"), ",") {
+		if market = strings.TrimSpace(market); market != "" {
+			markets[strings.ToUpper(market)] = true
+		}
+	}
+
+	g.stream(w, r, EventSummary, markets, func() []Event {
+		g.summaryMutex.RLock()
+		defer g.summaryMutex.RUnlock()
+
+		var initial []Event
+		for market, summary := range g.summaries {
+			if len(markets) == 0 || markets[market] {
+				initial = append(initial, Event{Type: EventSummary, Market: market, Data: summary})
+			}
+		}
+
+		return initial
+	})
+}
+
+func (g *Gateway) handleBookStream(w http.ResponseWriter, r *http.Request) {
+	market := strings.ToUpper(strings.TrimPrefix(r.URL.Path, "/stream/books/"))
+
+	followed, bookErr := g.followBook(market)
+	if bookErr != nil {
+		writeError(w, http.StatusBadGateway, bookErr)
+		return
+	}
+	defer g.releaseBook(followed)
+
+	g.stream(w, r, EventBook, map[string]bool{market: true}, func() []Event {
+		return []Event{{Type: EventBook, Market: market, Data: snapshot(followed.book, g.config.StreamDepth)}}
+	})
+}
+
+func (g *Gateway) accountStream(eventType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !g.config.Account {
+			writeError(w, http.StatusNotFound, fmt.Errorf("account streams are disabled"))
+			return
+		}
+
+		g.stream(w, r, eventType, nil, nil)
+	}
+}
+
+func queryInt(r *http.Request, name string) (int, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return 0, nil
+	}
+
+	value, parseErr := strconv.Atoi(raw)
+	if parseErr != nil || value < 0 {
+		return 0, fmt.Errorf("%s must be a whole number, got %q", name, raw)
+	}
+
+	return value, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
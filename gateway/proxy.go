@@ -0,0 +1,182 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2"
+)
+
+//publicCall one proxied public method.  market and bookType are only set for the methods that take them.
+type publicCall func(c *bittrex.Client, market, bookType string) (interface{}, error)
+
+var publicCalls = map[string]publicCall{
+	"getmarkets": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetMarkets()
+	},
+	"getcurrencies": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetCurrencies()
+	},
+	"getmarketsummaries": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetMarketSummaries()
+	},
+	"getmarketsummary": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetMarketSummary(market)
+	},
+	"getticker": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetTicker(market)
+	},
+	"getorderbook": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetOrderBook(market, bookType)
+	},
+	"getmarkethistory": func(c *bittrex.Client, market, bookType string) (interface{}, error) {
+		return c.PublicGetMarketHistory(market)
+	},
+}
+
+//methods taking no market argument.
+var marketless = map[string]bool{
+	"getmarkets":         true,
+	"getcurrencies":      true,
+	"getmarketsummaries": true,
+}
+
+var maxAgeDirective = regexp.MustCompile(`(?i)max-age\s*=\s*(\d+)`)
+
+//envelope the Bittrex response shape, so consumers can point their existing parsing at the gateway.
+type envelope struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Result  interface{} `json:"result"`
+}
+
+type cacheEntry struct {
+	mutex   sync.Mutex //held while fetching, so concurrent misses share one upstream call
+	body    []byte
+	fetched time.Time
+}
+
+type cache struct {
+	mutex   sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+func newCache() *cache {
+	return &cache{entries: make(map[string]*cacheEntry)}
+}
+
+func (c *cache) entry(key string) *cacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[key] = entry
+	}
+
+	return entry
+}
+
+//maxAge the configured staleness limit for method, tightened by the request's Cache-Control max-age if it asks for fresher.
+func (g *Gateway) maxAge(method string, r *http.Request) time.Duration {
+	limit, ok := g.config.MaxAge[method]
+	if !ok {
+		limit = DefaultMaxAge[method]
+	}
+
+	if match := maxAgeDirective.FindStringSubmatch(r.Header.Get("Cache-Control")); match != nil {
+		if seconds, parseErr := strconv.Atoi(match[1]); parseErr == nil {
+			if requested := time.Duration(seconds) * time.Second; requested < limit {
+				limit = requested
+			}
+		}
+	}
+
+	return limit
+}
+
+/*
+handleProxy answer a public REST call from the cache, or from upstream when the cached copy is older than maxAge.
+Refusals from the exchange (success false) are passed through but never cached.  The Age header and X-Cache,
+HIT or MISS, say which it was.
+*/
+func (g *Gateway) handleProxy(w http.ResponseWriter, r *http.Request) {
+	method := strings.ToLower(strings.TrimPrefix(r.URL.Path, "/api/v1.1/public/"))
+
+	call, ok := publicCalls[method]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, envelope{Message: fmt.Sprintf("unknown or unproxied method %q", method)})
+		return
+	}
+
+	market := strings.ToUpper(r.URL.Query().Get("market"))
+	if market == "" && !marketless[method] {
+		writeJSON(w, http.StatusBadRequest, envelope{Message: "MARKET_NOT_PROVIDED"})
+		return
+	}
+
+	bookType := ""
+	if method == "getorderbook" {
+		if bookType = strings.ToLower(r.URL.Query().Get("type")); bookType == "" {
+			bookType = "both"
+		}
+	}
+
+	entry := g.cache.entry(method + "?" + market + "&" + bookType)
+
+	body, fetched, hit, fetchErr := entry.get(g.maxAge(method, r), func() (interface{}, error) {
+		return call(g.client, market, bookType)
+	})
+
+	if fetchErr != nil {
+		if refused, isRefusal := fetchErr.(bittrex.APIError); isRefusal {
+			writeJSON(w, http.StatusOK, envelope{Message: refused.Message})
+			return
+		}
+
+		writeJSON(w, http.StatusBadGateway, envelope{Message: fetchErr.Error()})
+		return
+	}
+
+	cacheStatus := "MISS"
+	if hit {
+		cacheStatus = "HIT"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Age", strconv.Itoa(int(time.Since(fetched)/time.Second)))
+	w.Header().Set("X-Cache", cacheStatus)
+	w.Write(body)
+}
+
+//get the cached body if it is no older than limit, otherwise fetch and cache a fresh one.
+func (e *cacheEntry) get(limit time.Duration, fetch func() (interface{}, error)) ([]byte, time.Time, bool, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.body != nil && time.Since(e.fetched) <= limit {
+		return e.body, e.fetched, true, nil
+	}
+
+	result, fetchErr := fetch()
+	if fetchErr != nil {
+		return nil, time.Time{}, false, fetchErr
+	}
+
+	body, encodeErr := json.Marshal(envelope{Success: true, Result: result})
+	if encodeErr != nil {
+		return nil, time.Time{}, false, fmt.Errorf("gateway - encode response: %s", encodeErr.Error())
+	}
+
+	e.body = body
+	e.fetched = time.Now()
+
+	return e.body, e.fetched, false, nil
+}
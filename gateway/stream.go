@@ -0,0 +1,265 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+//heartbeat interval between keepalives on an idle stream.
+const heartbeat = 30 * time.Second
+
+//writeTimeout how long a websocket write may take before the consumer is dropped.
+const writeTimeout = 10 * time.Second
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+	//the gateway is meant for local services, not browsers on other origins.
+	CheckOrigin: func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		return origin == "" || strings.HasSuffix(origin, "://"+r.Host)
+	},
+}
+
+type encodedEvent struct {
+	eventType string
+	body      []byte
+}
+
+type consumer struct {
+	eventType string
+	markets   map[string]bool //empty for every market
+	events    chan encodedEvent
+	gone      chan struct{} //closed when the consumer falls behind or the gateway closes
+	goneOnce  sync.Once
+}
+
+func (c *consumer) drop() {
+	c.goneOnce.Do(func() { close(c.gone) })
+}
+
+//wants book errors go to the book's streams as well.
+func (c *consumer) wants(event Event) bool {
+	switch {
+	case event.Type == EventError && c.eventType == EventBook:
+		return c.markets[event.Market]
+	case event.Type != c.eventType:
+		return false
+	}
+
+	return len(c.markets) == 0 || c.markets[event.Market]
+}
+
+//goneEvent the last event a dropped consumer is sent.
+var goneEvent, _ = encodeEvent(Event{Type: EventError, Data: "disconnected: stream fell behind or gateway closed"})
+
+//hub fans events out to the consumers.
+type hub struct {
+	mutex     sync.Mutex
+	consumers map[*consumer]bool
+}
+
+func newHub() *hub {
+	return &hub{consumers: make(map[*consumer]bool)}
+}
+
+func encodeEvent(event Event) (encodedEvent, error) {
+	body, encodeErr := json.Marshal(event)
+	if encodeErr != nil {
+		return encodedEvent{}, fmt.Errorf("gateway - encode %s event: %s", event.Type, encodeErr.Error())
+	}
+
+	return encodedEvent{eventType: event.Type, body: body}, nil
+}
+
+//publish queue event for every consumer that wants it.  Consumers with a full queue are dropped, never waited on.
+func (h *hub) publish(event Event) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var encoded *encodedEvent
+
+	for c := range h.consumers {
+		if !c.wants(event) {
+			continue
+		}
+
+		if encoded == nil {
+			e, encodeErr := encodeEvent(event)
+			if encodeErr != nil {
+				return
+			}
+			encoded = &e
+		}
+
+		select {
+		case c.events <- *encoded:
+		default:
+			c.drop()
+			delete(h.consumers, c)
+		}
+	}
+}
+
+//add register c, queueing initial's events first so nothing published meanwhile can arrive ahead of them.
+func (h *hub) add(c *consumer, initial func() []Event) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var events []Event
+	if initial != nil {
+		events = initial()
+	}
+
+	for _, event := range events {
+		encoded, encodeErr := encodeEvent(event)
+		if encodeErr != nil {
+			continue
+		}
+
+		select {
+		case c.events <- encoded:
+		default:
+		}
+	}
+
+	h.consumers[c] = true
+}
+
+func (h *hub) remove(c *consumer) {
+	h.mutex.Lock()
+	delete(h.consumers, c)
+	h.mutex.Unlock()
+}
+
+func (h *hub) count() int {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	return len(h.consumers)
+}
+
+func (h *hub) closeAll() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for c := range h.consumers {
+		c.drop()
+		delete(h.consumers, c)
+	}
+}
+
+/*
+stream serve events of eventType, for markets, until the consumer disconnects or falls behind.  initial, when set,
+is called under the hub's lock for the state to send first.
+*/
+func (g *Gateway) stream(w http.ResponseWriter, r *http.Request, eventType string, markets map[string]bool, initial func() []Event) {
+	c := &consumer{
+		eventType: eventType,
+		markets:   markets,
+		events:    make(chan encodedEvent, g.config.StreamBuffer),
+		gone:      make(chan struct{}),
+	}
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		conn, upgradeErr := upgrader.Upgrade(w, r, nil)
+		if upgradeErr != nil {
+			//Upgrade has already answered the request.
+			return
+		}
+		defer conn.Close()
+
+		g.hub.add(c, initial)
+		defer g.hub.remove(c)
+
+		serveWebSocket(conn, c)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming is not supported by this connection"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	g.hub.add(c, initial)
+	defer g.hub.remove(c)
+
+	serveEvents(w, flusher, r, c)
+}
+
+func serveEvents(w http.ResponseWriter, flusher http.Flusher, r *http.Request, c *consumer) {
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c.gone:
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", goneEvent.eventType, goneEvent.body)
+			flusher.Flush()
+			return
+		case <-ticker.C:
+			if _, writeErr := fmt.Fprint(w, ": keepalive\n\n"); writeErr != nil {
+				return
+			}
+			flusher.Flush()
+		case event := <-c.events:
+			if _, writeErr := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.eventType, event.body); writeErr != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func serveWebSocket(conn *websocket.Conn, c *consumer) {
+	//consumers have nothing to say, but reading is what notices them leaving and answers their pings.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, readErr := conn.ReadMessage(); readErr != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-c.gone:
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			conn.WriteMessage(websocket.TextMessage, goneEvent.body)
+			conn.WriteMessage(websocket.CloseMessage, []byte{})
+			return
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if writeErr := conn.WriteMessage(websocket.PingMessage, nil); writeErr != nil {
+				return
+			}
+		case event := <-c.events:
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if writeErr := conn.WriteMessage(websocket.TextMessage, event.body); writeErr != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package bittrex
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//LedgerEntryType kind of ledger row.
+type LedgerEntryType string
+
+//Ledger Entry Types
+const (
+	LedgerBuy        LedgerEntryType = "BUY"
+	LedgerSell       LedgerEntryType = "SELL"
+	LedgerDeposit    LedgerEntryType = "DEPOSIT"
+	LedgerWithdrawal LedgerEntryType = "WITHDRAWAL"
+)
+
+//LedgerFormat CSV layout written by WriteLedgerCSV.
+type LedgerFormat int
+
+//Ledger Formats
+const (
+	LedgerGeneric      LedgerFormat = iota //every LedgerRow field
+	LedgerKoinly                           //Koinly universal import
+	LedgerCoinTracking                     //CoinTracking custom exchange import
+)
+
+/*
+LedgerRow one trade, deposit or withdrawal.  Trades are in Market, with Currency bought or sold and priced in Quote.
+Fee is the commission in the quote currency for trades, and the network fee in Currency for withdrawals.
+CostBasis, Proceeds (net of the fee) and Gain are set on sells, from the PnL lots.
+*/
+type LedgerRow struct {
+	Time        time.Time
+	Type        LedgerEntryType
+	Market      string
+	Currency    string
+	Quantity    decimal
+	Quote       string
+	Price       decimal
+	Total       decimal
+	Fee         decimal
+	FeeCurrency string
+	CostBasis   decimal
+	Proceeds    decimal
+	Gain        decimal
+	//UnknownBasis part of the sale had no matching buy, so CostBasis is understated.
+	UnknownBasis bool
+	OrderUUID    string
+	TxID         string
+	Address      string
+}
+
+/*
+BuildLedger combine the order, deposit and withdrawal histories into ledger rows for year, oldest first.
+year zero returns every year.  Lots are built from the whole history, so sales in year carry the cost of earlier buys.
+Cancelled withdrawals and withdrawals to invalid addresses are left out.
+*/
+func (c *Client) BuildLedger(year int, method CostMethod) ([]LedgerRow, error) {
+	orders, ordersErr := c.AccountGetOrderHistory("")
+	if ordersErr != nil {
+		return nil, ordersErr
+	}
+
+	deposits, depositErr := c.AccountGetDepositHistory("")
+	if depositErr != nil {
+		return nil, depositErr
+	}
+
+	withdrawals, withdrawalErr := c.AccountGetWithdrawalHistory("")
+	if withdrawalErr != nil {
+		return nil, withdrawalErr
+	}
+
+	return c.buildLedger(orders, deposits, withdrawals, year, method)
+}
+
+//buildLedger BuildLedger from histories already fetched.  orders is sorted in place.
+func (c *Client) buildLedger(orders []AccountOrderHistoryDescription, deposits, withdrawals []TransactionHistoryDescription, year int, method CostMethod) ([]LedgerRow, error) {
+	engine := NewPnLEngine(c, PnLConfig{Method: method})
+	if bookErr := engine.bookHistory(orders); bookErr != nil {
+		return nil, bookErr
+	}
+
+	sales := make(map[string][]Disposal)
+	for _, disposal := range engine.Disposals() {
+		sales[disposal.SellOrderUUID] = append(sales[disposal.SellOrderUUID], disposal)
+	}
+
+	var rows []LedgerRow
+
+	for _, order := range orders {
+		filled := order.Quantity - order.QuantityRemaining
+		if filled <= quantityEpsilon/2 {
+			continue
+		}
+
+		parsed, parseErr := ParseMarket(order.Exchange)
+		if parseErr != nil {
+			return nil, fmt.Errorf("ledger - order %s: %s", order.OrderUUID, parseErr.Error())
+		}
+
+		price := order.PricePerUnit
+		if price <= 0 {
+			price = order.Price / filled
+		}
+
+		row := LedgerRow{
+			Time:        time.Time(order.TimeStamp),
+			Type:        LedgerBuy,
+			Market:      parsed.String(),
+			Currency:    parsed.Base(),
+			Quantity:    filled,
+			Quote:       parsed.Quote(),
+			Price:       price,
+			Total:       filled * price,
+			Fee:         order.Commission,
+			FeeCurrency: parsed.Quote(),
+			OrderUUID:   order.OrderUUID,
+		}
+
+		if !strings.Contains(strings.ToUpper(order.OrderType), "BUY") {
+			row.Type = LedgerSell
+
+			for _, disposal := range sales[order.OrderUUID] {
+				row.CostBasis += disposal.CostBasis
+				row.Proceeds += disposal.Proceeds
+				//an unmatched disposal has no gain of its own; the row reports it against the understated basis.
+				row.Gain += disposal.Proceeds - disposal.CostBasis
+				row.UnknownBasis = row.UnknownBasis || disposal.Unmatched
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	for _, deposit := range deposits {
+		address := deposit.CryptoAddress
+		if address == "" {
+			address = deposit.Address
+		}
+
+		at := time.Time(deposit.LastUpdated)
+		if at.IsZero() {
+			at = time.Time(deposit.Opened)
+		}
+
+		rows = append(rows, LedgerRow{
+			Time:     at,
+			Type:     LedgerDeposit,
+			Currency: strings.ToUpper(deposit.Currency),
+			Quantity: deposit.Amount,
+			TxID:     deposit.TxID,
+			Address:  address,
+		})
+	}
+
+	for _, withdrawal := range withdrawals {
+		if withdrawal.Canceled || withdrawal.InvalidAddress {
+			continue
+		}
+
+		currency := strings.ToUpper(withdrawal.Currency)
+
+		rows = append(rows, LedgerRow{
+			Time:        time.Time(withdrawal.Opened),
+			Type:        LedgerWithdrawal,
+			Currency:    currency,
+			Quantity:    withdrawal.Amount,
+			Fee:         withdrawal.TxCost,
+			FeeCurrency: currency,
+			OrderUUID:   withdrawal.PaymentUUID,
+			TxID:        withdrawal.TxID,
+			Address:     withdrawal.Address,
+		})
+	}
+
+	if year != 0 {
+		inYear := rows[:0]
+		for _, row := range rows {
+			if row.Time.UTC().Year() == year {
+				inYear = append(inYear, row)
+			}
+		}
+		rows = inYear
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Time.Before(rows[j].Time)
+	})
+
+	return rows, nil
+}
+
+//WriteLedgerCSV write rows to w in format, header first.
+func WriteLedgerCSV(w io.Writer, rows []LedgerRow, format LedgerFormat) error {
+	var header []string
+	var record func(LedgerRow) []string
+
+	switch format {
+	case LedgerGeneric:
+		header = []string{
+			"Date", "Type", "Market", "Currency", "Quantity", "Quote", "Price", "Total", "Fee", "Fee Currency",
+			"Cost Basis", "Proceeds", "Gain", "Unknown Basis", "Order UUID", "TxID", "Address",
+		}
+		record = genericLedgerRecord
+	case LedgerKoinly:
+		header = []string{
+			"Date", "Sent Amount", "Sent Currency", "Received Amount", "Received Currency", "Fee Amount", "Fee Currency",
+			"Net Worth Amount", "Net Worth Currency", "Label", "Description", "TxHash",
+		}
+		record = koinlyLedgerRecord
+	case LedgerCoinTracking:
+		header = []string{
+			"Type", "Buy Amount", "Buy Currency", "Sell Amount", "Sell Currency", "Fee", "Fee Currency", "Exchange",
+			"Trade-Group", "Comment", "Date",
+		}
+		record = coinTrackingLedgerRecord
+	default:
+		return fmt.Errorf("WriteLedgerCSV - unknown format %d", format)
+	}
+
+	writer := csv.NewWriter(w)
+
+	if writeErr := writer.Write(header); writeErr != nil {
+		return writeErr
+	}
+
+	for _, row := range rows {
+		if writeErr := writer.Write(record(row)); writeErr != nil {
+			return writeErr
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func formatAmount(value decimal) string {
+	return strconv.FormatFloat(value, 'f', 8, 64)
+}
+
+//formatOptionalAmount blank instead of zero, for columns that don't apply to a row.
+func formatOptionalAmount(value decimal) string {
+	if value == 0 {
+		return ""
+	}
+
+	return formatAmount(value)
+}
+
+func genericLedgerRecord(row LedgerRow) []string {
+	gainColumns := []string{"", "", "", ""}
+	if row.Type == LedgerSell {
+		gainColumns = []string{formatAmount(row.CostBasis), formatAmount(row.Proceeds), formatAmount(row.Gain), strconv.FormatBool(row.UnknownBasis)}
+	}
+
+	return append(append([]string{
+		row.Time.UTC().Format(time.RFC3339),
+		string(row.Type),
+		row.Market,
+		row.Currency,
+		formatAmount(row.Quantity),
+		row.Quote,
+		formatOptionalAmount(row.Price),
+		formatOptionalAmount(row.Total),
+		formatOptionalAmount(row.Fee),
+		row.FeeCurrency,
+	}, gainColumns...), row.OrderUUID, row.TxID, row.Address)
+}
+
+func koinlyLedgerRecord(row LedgerRow) []string {
+	var sentAmount, sentCurrency, receivedAmount, receivedCurrency, description string
+
+	switch row.Type {
+	case LedgerBuy:
+		sentAmount, sentCurrency = formatAmount(row.Total), row.Quote
+		receivedAmount, receivedCurrency = formatAmount(row.Quantity), row.Currency
+		description = "Bittrex " + row.Market + " order " + row.OrderUUID
+	case LedgerSell:
+		sentAmount, sentCurrency = formatAmount(row.Quantity), row.Currency
+		receivedAmount, receivedCurrency = formatAmount(row.Total), row.Quote
+		description = "Bittrex " + row.Market + " order " + row.OrderUUID
+	case LedgerDeposit:
+		receivedAmount, receivedCurrency = formatAmount(row.Quantity), row.Currency
+		description = "Bittrex deposit"
+	case LedgerWithdrawal:
+		sentAmount, sentCurrency = formatAmount(row.Quantity), row.Currency
+		description = "Bittrex withdrawal to " + row.Address
+	}
+
+	return []string{
+		row.Time.UTC().Format("2006-01-02 15:04:05 UTC"),
+		sentAmount,
+		sentCurrency,
+		receivedAmount,
+		receivedCurrency,
+		formatOptionalAmount(row.Fee),
+		row.FeeCurrency,
+		"",
+		"",
+		"",
+		description,
+		row.TxID,
+	}
+}
+
+func coinTrackingLedgerRecord(row LedgerRow) []string {
+	var kind, buyAmount, buyCurrency, sellAmount, sellCurrency, comment string
+
+	switch row.Type {
+	case LedgerBuy:
+		kind = "Trade"
+		buyAmount, buyCurrency = formatAmount(row.Quantity), row.Currency
+		sellAmount, sellCurrency = formatAmount(row.Total), row.Quote
+		comment = row.OrderUUID
+	case LedgerSell:
+		kind = "Trade"
+		buyAmount, buyCurrency = formatAmount(row.Total), row.Quote
+		sellAmount, sellCurrency = formatAmount(row.Quantity), row.Currency
+		comment = row.OrderUUID
+	case LedgerDeposit:
+		kind = "Deposit"
+		buyAmount, buyCurrency = formatAmount(row.Quantity), row.Currency
+		comment = row.TxID
+	case LedgerWithdrawal:
+		kind = "Withdrawal"
+		sellAmount, sellCurrency = formatAmount(row.Quantity), row.Currency
+		comment = row.TxID
+	}
+
+	return []string{
+		kind,
+		buyAmount,
+		buyCurrency,
+		sellAmount,
+		sellCurrency,
+		formatOptionalAmount(row.Fee),
+		row.FeeCurrency,
+		"Bittrex",
+		"",
+		comment,
+		row.Time.UTC().Format("2006-01-02 15:04:05"),
+	}
+}
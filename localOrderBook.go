@@ -0,0 +1,407 @@
+package bittrex
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+const (
+	//reorderWindow how long a delta that arrived early waits for the ones before it before the book resyncs.
+	reorderWindow = 500 * time.Millisecond
+	//maxPending early deltas held before the book stops waiting and resyncs.
+	maxPending = 100
+	//maxBuffered deltas kept while a snapshot is fetched.  The oldest are dropped first; the snapshot covers them.
+	maxBuffered = 1000
+	//maxResyncBackoff longest wait between failed snapshot requests.
+	maxResyncBackoff = 30 * time.Second
+)
+
+/*
+LocalOrderBook order book for one market, seeded from QueryExchangeState and kept current from the exchange deltas.
+Deltas received while the snapshot is being fetched are buffered and applied on top of it.  The socket delivers
+deltas concurrently, so one that arrives ahead of its predecessors is held for up to reorderWindow; a gap that
+doesn't fill in that time triggers a fresh snapshot, retried with backoff until it succeeds or the book is closed.
+*/
+type LocalOrderBook struct {
+	client *Client
+	market string
+
+	mutex    sync.RWMutex
+	bids     map[decimal]decimal //rate -> quantity
+	asks     map[decimal]decimal
+	nonce    int
+	synced   bool
+	syncing  bool
+	buffered []socketPayloads.ExchangeDelta
+	pending  map[int]socketPayloads.ExchangeDelta //nonce -> delta that arrived ahead of its predecessors
+	gapTimer *time.Timer
+
+	updateMutex sync.RWMutex
+	onUpdate    map[int]func()
+	nextID      int
+
+	removeListener func()
+	errChan        chan error
+	closed         chan struct{}
+	closeOnce      sync.Once
+}
+
+//NewLocalOrderBook subscribe to the market's exchange deltas and load the initial snapshot.
+func NewLocalOrderBook(c *Client, market string) (*LocalOrderBook, error) {
+	b := &LocalOrderBook{
+		client:   c,
+		market:   market,
+		bids:     make(map[decimal]decimal),
+		asks:     make(map[decimal]decimal),
+		syncing:  true,
+		pending:  make(map[int]socketPayloads.ExchangeDelta),
+		onUpdate: make(map[int]func()),
+		errChan:  make(chan error, 5),
+		closed:   make(chan struct{}),
+	}
+
+	var listenErr error
+	if b.removeListener, listenErr = c.addExchangeListener(market, b.apply); listenErr != nil {
+		return nil, listenErr
+	}
+
+	if syncErr := b.resync(); syncErr != nil {
+		b.Close()
+		return nil, syncErr
+	}
+
+	return b, nil
+}
+
+//Market name of the market this book follows.
+func (b *LocalOrderBook) Market() string {
+	return b.market
+}
+
+//Errors failed resynchronisations, which are retried.  Buffered; errors are dropped if it fills.
+func (b *LocalOrderBook) Errors() chan error {
+	return b.errChan
+}
+
+//Close stop following the exchange deltas, and stop retrying a failed resynchronisation.
+func (b *LocalOrderBook) Close() {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+		b.removeListener()
+
+		b.mutex.Lock()
+		b.stopGapTimerLocked()
+		b.mutex.Unlock()
+	})
+}
+
+//OnUpdate call fn after every change to the book.  fn must not block.  The returned func removes it.
+func (b *LocalOrderBook) OnUpdate(fn func()) func() {
+	b.updateMutex.Lock()
+	b.nextID++
+	id := b.nextID
+	b.onUpdate[id] = fn
+	b.updateMutex.Unlock()
+
+	return func() {
+		b.updateMutex.Lock()
+		delete(b.onUpdate, id)
+		b.updateMutex.Unlock()
+	}
+}
+
+//Synced false while the book is waiting on a snapshot.
+func (b *LocalOrderBook) Synced() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.synced
+}
+
+//Nonce of the last delta applied.
+func (b *LocalOrderBook) Nonce() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return b.nonce
+}
+
+//BestBid highest buy order.  false if there are no bids.
+func (b *LocalOrderBook) BestBid() (OrderElement, bool) {
+	bids := b.Bids(1)
+	if len(bids) == 0 {
+		return OrderElement{}, false
+	}
+
+	return bids[0], true
+}
+
+//BestAsk lowest sell order.  false if there are no asks.
+func (b *LocalOrderBook) BestAsk() (OrderElement, bool) {
+	asks := b.Asks(1)
+	if len(asks) == 0 {
+		return OrderElement{}, false
+	}
+
+	return asks[0], true
+}
+
+//Bids buy orders, best first.  depth of zero or less returns every level.
+func (b *LocalOrderBook) Bids(depth int) []OrderElement {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return levels(b.bids, depth, true)
+}
+
+//Asks sell orders, best first.  depth of zero or less returns every level.
+func (b *LocalOrderBook) Asks(depth int) []OrderElement {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return levels(b.asks, depth, false)
+}
+
+//Snapshot both sides of the book, in the same shape as PublicGetOrderBook.
+func (b *LocalOrderBook) Snapshot(depth int) OrderBook {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return OrderBook{
+		Buy:  levels(b.bids, depth, true),
+		Sell: levels(b.asks, depth, false),
+	}
+}
+
+func levels(side map[decimal]decimal, depth int, descending bool) []OrderElement {
+	result := make([]OrderElement, 0, len(side))
+	for rate, quantity := range side {
+		result = append(result, OrderElement{Quantity: quantity, Rate: rate})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if descending {
+			return result[i].Rate > result[j].Rate
+		}
+		return result[i].Rate < result[j].Rate
+	})
+
+	if depth > 0 && len(result) > depth {
+		result = result[:depth]
+	}
+
+	return result
+}
+
+func (b *LocalOrderBook) apply(delta socketPayloads.ExchangeDelta) {
+	b.mutex.Lock()
+
+	if !b.synced {
+		b.bufferLocked(delta)
+		b.mutex.Unlock()
+		return
+	}
+
+	if delta.Nonce <= b.nonce {
+		b.mutex.Unlock()
+		return
+	}
+
+	if delta.Nonce != b.nonce+1 {
+		//the deltas before this one may still be on their way; wait for them before giving up on the book.
+		b.pending[delta.Nonce] = delta
+		if len(b.pending) > maxPending {
+			b.startResyncLocked()
+		} else if b.gapTimer == nil {
+			b.gapTimer = time.AfterFunc(reorderWindow, b.gapExpired)
+		}
+		b.mutex.Unlock()
+		return
+	}
+
+	b.applyLocked(delta)
+	b.drainPendingLocked()
+	b.mutex.Unlock()
+
+	b.notify()
+}
+
+//bufferLocked keep delta for after the snapshot.  must be called with the mutex held.
+func (b *LocalOrderBook) bufferLocked(delta socketPayloads.ExchangeDelta) {
+	b.buffered = append(b.buffered, delta)
+	if len(b.buffered) > maxBuffered {
+		b.buffered = append(b.buffered[:0], b.buffered[len(b.buffered)-maxBuffered:]...)
+	}
+}
+
+//drainPendingLocked apply held deltas that now follow on.  must be called with the mutex held.
+func (b *LocalOrderBook) drainPendingLocked() {
+	for {
+		next, found := b.pending[b.nonce+1]
+		if !found {
+			break
+		}
+
+		delete(b.pending, next.Nonce)
+		b.applyLocked(next)
+	}
+
+	for nonce := range b.pending {
+		if nonce <= b.nonce {
+			delete(b.pending, nonce)
+		}
+	}
+
+	if len(b.pending) == 0 {
+		b.stopGapTimerLocked()
+	}
+}
+
+func (b *LocalOrderBook) stopGapTimerLocked() {
+	if b.gapTimer != nil {
+		b.gapTimer.Stop()
+		b.gapTimer = nil
+	}
+}
+
+func (b *LocalOrderBook) gapExpired() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.gapTimer = nil
+
+	if b.synced && len(b.pending) > 0 {
+		//missed at least one delta, so the book can no longer be trusted.
+		b.startResyncLocked()
+	}
+}
+
+//startResyncLocked fetch a fresh snapshot, keeping the held deltas to apply on top.  must be called with the mutex held.
+func (b *LocalOrderBook) startResyncLocked() {
+	b.synced = false
+	b.stopGapTimerLocked()
+
+	for _, delta := range b.pending {
+		b.bufferLocked(delta)
+	}
+	b.pending = make(map[int]socketPayloads.ExchangeDelta)
+
+	if b.syncing {
+		return
+	}
+
+	b.syncing = true
+	go b.resyncUntilSynced()
+}
+
+//resyncUntilSynced retry resync with backoff until it succeeds or the book is closed.  failures go to Errors.
+func (b *LocalOrderBook) resyncUntilSynced() {
+	backoff := time.Second
+
+	for {
+		syncErr := b.resync()
+		if syncErr == nil {
+			return
+		}
+
+		select {
+		case b.errChan <- syncErr:
+		default:
+		}
+
+		select {
+		case <-b.closed:
+			b.mutex.Lock()
+			b.syncing = false
+			b.mutex.Unlock()
+			return
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxResyncBackoff {
+			backoff = maxResyncBackoff
+		}
+	}
+}
+
+//applyLocked must be called with the mutex held.
+func (b *LocalOrderBook) applyLocked(delta socketPayloads.ExchangeDelta) {
+	for _, order := range delta.Buys {
+		applyLevel(b.bids, order)
+	}
+
+	for _, order := range delta.Sells {
+		applyLevel(b.asks, order)
+	}
+
+	b.nonce = delta.Nonce
+}
+
+func applyLevel(side map[decimal]decimal, order socketPayloads.ExchangeOrder) {
+	switch order.Type {
+	case socketPayloads.Add, socketPayloads.Update:
+		if order.Quantity <= 0 {
+			delete(side, order.Rate)
+			return
+		}
+		side[order.Rate] = order.Quantity
+	default:
+		delete(side, order.Rate)
+	}
+}
+
+func (b *LocalOrderBook) resync() error {
+	state, queryErr := b.client.QueryExchangeState(b.market)
+
+	if queryErr != nil {
+		return fmt.Errorf("order book %s - query exchange state: %s", b.market, queryErr.Error())
+	}
+
+	bids := make(map[decimal]decimal, len(state.Buys))
+	for _, order := range state.Buys {
+		bids[order.Rate] = order.Quantity
+	}
+
+	asks := make(map[decimal]decimal, len(state.Sells))
+	for _, order := range state.Sells {
+		asks[order.Rate] = order.Quantity
+	}
+
+	b.mutex.Lock()
+	b.bids = bids
+	b.asks = asks
+	b.nonce = state.Nonce
+	b.synced = true
+	b.syncing = false
+
+	for _, delta := range b.buffered {
+		if delta.Nonce > b.nonce {
+			b.pending[delta.Nonce] = delta
+		}
+	}
+	b.buffered = nil
+
+	b.drainPendingLocked()
+	if len(b.pending) > 0 && b.gapTimer == nil {
+		//the snapshot is older than some buffered deltas; the gap gets the same window as any other.
+		b.gapTimer = time.AfterFunc(reorderWindow, b.gapExpired)
+	}
+	b.mutex.Unlock()
+
+	b.notify()
+
+	return nil
+}
+
+func (b *LocalOrderBook) notify() {
+	b.updateMutex.RLock()
+	defer b.updateMutex.RUnlock()
+
+	for _, fn := range b.onUpdate {
+		fn()
+	}
+}
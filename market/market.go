@@ -0,0 +1,182 @@
+/*
+Package market provides a typed trading pair, so code juggling several exchanges doesn't have to
+re-split "BTC-LTC" style strings by hand.
+
+Naming follows the usual pair convention rather than Bittrex's field names: in LTC/BTC, LTC is the base
+(what is bought or sold) and BTC is the quote (what it is priced in).  Bittrex calls BTC the BaseCurrency
+of "BTC-LTC", so MarketDescription.BaseCurrency corresponds to Quote() here.
+*/
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//Style output format for a Market.
+type Style int
+
+//Market Styles
+const (
+	StyleBittrex      Style = iota //BTC-LTC
+	StyleSlash                     //LTC/BTC
+	StyleConcatenated              //LTCBTC
+	StyleUnderscore                //LTC_BTC
+)
+
+/*
+KnownQuotes currencies recognised as the quote when parsing concatenated symbols such as "LTCBTC".
+Longer codes are tried first, so "USDT" wins over "USD".  Add to it if you need more.
+*/
+var KnownQuotes = []string{"BTC", "ETH", "USDT", "USD", "EUR", "USDC", "TUSD"}
+
+//Market a trading pair.
+type Market struct {
+	base  string
+	quote string
+}
+
+//New construct a Market from its base (traded) and quote (pricing) currencies.
+func New(base, quote string) Market {
+	return Market{
+		base:  strings.ToUpper(strings.TrimSpace(base)),
+		quote: strings.ToUpper(strings.TrimSpace(quote)),
+	}
+}
+
+/*
+Parse read a market from any of the common notations:
+	"BTC-LTC"  bittrex, quote first
+	"LTC/BTC"  base first
+	"LTC_BTC"  base first
+	"LTCBTC"   concatenated, split on KnownQuotes
+Case is ignored.
+*/
+func Parse(symbol string) (Market, error) {
+	trimmed := strings.ToUpper(strings.TrimSpace(symbol))
+
+	if parts := strings.Split(trimmed, "-"); len(parts) == 2 {
+		return newChecked(symbol, parts[1], parts[0])
+	}
+
+	if parts := strings.Split(trimmed, "/"); len(parts) == 2 {
+		return newChecked(symbol, parts[0], parts[1])
+	}
+
+	if parts := strings.Split(trimmed, "_"); len(parts) == 2 {
+		return newChecked(symbol, parts[0], parts[1])
+	}
+
+	quotes := make([]string, len(KnownQuotes))
+	copy(quotes, KnownQuotes)
+	sort.Slice(quotes, func(i, j int) bool { return len(quotes[i]) > len(quotes[j]) })
+
+	for _, quote := range quotes {
+		if strings.HasSuffix(trimmed, quote) && len(trimmed) > len(quote) {
+			return newChecked(symbol, strings.TrimSuffix(trimmed, quote), quote)
+		}
+	}
+
+	return Market{}, fmt.Errorf("unable to parse market %q", symbol)
+}
+
+//MustParse Parse, panicking on failure.  Intended for constants and tests.
+func MustParse(symbol string) Market {
+	m, err := Parse(symbol)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+func newChecked(symbol, base, quote string) (Market, error) {
+	m := New(base, quote)
+
+	if m.base == "" || m.quote == "" {
+		return Market{}, fmt.Errorf("unable to parse market %q", symbol)
+	}
+
+	return m, nil
+}
+
+//Base currency being bought or sold, eg LTC in BTC-LTC.
+func (m Market) Base() string {
+	return m.base
+}
+
+//Quote currency prices are given in, eg BTC in BTC-LTC.
+func (m Market) Quote() string {
+	return m.quote
+}
+
+//IsZero true for the zero value.
+func (m Market) IsZero() bool {
+	return m.base == "" && m.quote == ""
+}
+
+//Inverse swap base and quote.
+func (m Market) Inverse() Market {
+	return Market{base: m.quote, quote: m.base}
+}
+
+//Format render the market in the given style.
+func (m Market) Format(style Style) string {
+	switch style {
+	case StyleSlash:
+		return m.base + "/" + m.quote
+	case StyleConcatenated:
+		return m.base + m.quote
+	case StyleUnderscore:
+		return m.base + "_" + m.quote
+	default:
+		return m.quote + "-" + m.base
+	}
+}
+
+//String implement stringer interface.  Uses the bittrex notation so the value can be passed straight to the api.
+func (m Market) String() string {
+	if m.IsZero() {
+		return ""
+	}
+
+	return m.Format(StyleBittrex)
+}
+
+//MarshalText implement encoding.TextMarshaler, using the bittrex notation.
+func (m Market) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+//UnmarshalText implement encoding.TextUnmarshaler, accepting anything Parse does.
+func (m *Market) UnmarshalText(raw []byte) error {
+	if len(raw) == 0 {
+		*m = Market{}
+		return nil
+	}
+
+	parsed, err := Parse(string(raw))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+//MarshalJSON implement json.Marshaler
+func (m Market) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+//UnmarshalJSON implement json.Unmarshaler
+func (m *Market) UnmarshalJSON(raw []byte) error {
+	var symbol string
+	if err := json.Unmarshal(raw, &symbol); err != nil {
+		return err
+	}
+
+	return m.UnmarshalText([]byte(symbol))
+}
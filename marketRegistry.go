@@ -0,0 +1,364 @@
+package bittrex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//MarketRegistryEventType kind of change detected between two registry refreshes.
+type MarketRegistryEventType int
+
+//Market Registry Event Types
+const (
+	MarketListed MarketRegistryEventType = iota
+	MarketDelisted
+	MarketActivated
+	MarketDeactivated
+	CurrencyActivated
+	CurrencyDeactivated
+	CurrencyListed
+	CurrencyDelisted
+)
+
+//MarketRegistryEvent change notification sent to registry subscribers.
+type MarketRegistryEvent struct {
+	Type     MarketRegistryEventType
+	Market   string //set for market events
+	Currency string //set for currency events
+}
+
+//MarketValidationError returned when a market, currency or trade size is rejected locally.
+type MarketValidationError struct {
+	Market   string
+	Currency string
+	Reason   string
+}
+
+//Error implement error interface
+func (e MarketValidationError) Error() string {
+	if e.Currency != "" {
+		return fmt.Sprintf("validate currency %s: %s", e.Currency, e.Reason)
+	}
+
+	return fmt.Sprintf("validate market %s: %s", e.Market, e.Reason)
+}
+
+/*
+MarketRegistry cached view of public/getmarkets and public/getcurrencies.
+Once attached to a Client with UseMarketRegistry, order and withdrawal methods are checked against it
+before any request is sent.
+*/
+type MarketRegistry struct {
+	//dropped first, so it is 64-bit aligned for atomic access on 32-bit platforms.
+	dropped uint64
+
+	client *Client
+
+	mutex      sync.RWMutex
+	markets    map[string]MarketDescription
+	currencies map[string]Currency
+	refreshed  time.Time
+
+	subscriberMutex sync.RWMutex
+	subscribers     []chan MarketRegistryEvent
+
+	stopMutex sync.Mutex
+	stop      chan struct{}
+}
+
+//NewMarketRegistry construct a registry and perform the initial load.
+func NewMarketRegistry(c *Client) (*MarketRegistry, error) {
+	r := &MarketRegistry{
+		client:     c,
+		markets:    make(map[string]MarketDescription),
+		currencies: make(map[string]Currency),
+	}
+
+	if refreshErr := r.Refresh(); refreshErr != nil {
+		return nil, refreshErr
+	}
+
+	return r, nil
+}
+
+//UseMarketRegistry validate order and withdrawal arguments against the registry.  Set to nil to disable.
+func (c *Client) UseMarketRegistry(r *MarketRegistry) {
+	c.marketRegistry = r
+}
+
+/*
+Refresh reload markets and currencies.  Subscribers are notified of market and currency listings, delistings and
+IsActive changes.  Nothing is notified on the initial load.
+*/
+func (r *MarketRegistry) Refresh() error {
+	markets, marketsErr := r.client.PublicGetMarkets()
+	if marketsErr != nil {
+		return marketsErr
+	}
+
+	currencies, currenciesErr := r.client.PublicGetCurrencies()
+	if currenciesErr != nil {
+		return currenciesErr
+	}
+
+	newMarkets := make(map[string]MarketDescription, len(markets))
+	for _, m := range markets {
+		newMarkets[strings.ToUpper(m.MarketName)] = m
+	}
+
+	newCurrencies := make(map[string]Currency, len(currencies))
+	for _, cur := range currencies {
+		newCurrencies[strings.ToUpper(cur.Currency)] = cur
+	}
+
+	r.mutex.Lock()
+	var events []MarketRegistryEvent
+	if !r.refreshed.IsZero() {
+		events = diffRegistry(r.markets, newMarkets, r.currencies, newCurrencies)
+	}
+	r.markets = newMarkets
+	r.currencies = newCurrencies
+	r.refreshed = time.Now()
+	r.mutex.Unlock()
+
+	for _, event := range events {
+		r.publish(event)
+	}
+
+	return nil
+}
+
+func diffRegistry(
+	oldMarkets, newMarkets map[string]MarketDescription,
+	oldCurrencies, newCurrencies map[string]Currency,
+) []MarketRegistryEvent {
+	var events []MarketRegistryEvent
+
+	for name, m := range newMarkets {
+		old, existed := oldMarkets[name]
+
+		switch {
+		case !existed:
+			events = append(events, MarketRegistryEvent{Type: MarketListed, Market: m.MarketName})
+		case old.IsActive && !m.IsActive:
+			events = append(events, MarketRegistryEvent{Type: MarketDeactivated, Market: m.MarketName})
+		case !old.IsActive && m.IsActive:
+			events = append(events, MarketRegistryEvent{Type: MarketActivated, Market: m.MarketName})
+		}
+	}
+
+	for name, m := range oldMarkets {
+		if _, exists := newMarkets[name]; !exists {
+			events = append(events, MarketRegistryEvent{Type: MarketDelisted, Market: m.MarketName})
+		}
+	}
+
+	for name, cur := range newCurrencies {
+		old, existed := oldCurrencies[name]
+
+		switch {
+		case !existed:
+			events = append(events, MarketRegistryEvent{Type: CurrencyListed, Currency: cur.Currency})
+		case old.IsActive && !cur.IsActive:
+			events = append(events, MarketRegistryEvent{Type: CurrencyDeactivated, Currency: cur.Currency})
+		case !old.IsActive && cur.IsActive:
+			events = append(events, MarketRegistryEvent{Type: CurrencyActivated, Currency: cur.Currency})
+		}
+	}
+
+	for name, cur := range oldCurrencies {
+		if _, exists := newCurrencies[name]; !exists {
+			events = append(events, MarketRegistryEvent{Type: CurrencyDelisted, Currency: cur.Currency})
+		}
+	}
+
+	return events
+}
+
+/*
+AutoRefresh refresh the registry every interval until Stop is called.
+Refresh failures are sent on the returned channel; the previous data stays in use.
+*/
+func (r *MarketRegistry) AutoRefresh(interval time.Duration) chan error {
+	r.stopMutex.Lock()
+	if r.stop != nil {
+		close(r.stop)
+	}
+	stop := make(chan struct{})
+	r.stop = stop
+	r.stopMutex.Unlock()
+
+	errChan := make(chan error, 5)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if refreshErr := r.Refresh(); refreshErr != nil {
+					select {
+					case errChan <- refreshErr:
+					default:
+					}
+				}
+			}
+		}
+	}()
+
+	return errChan
+}
+
+//Stop end automatic refreshing.
+func (r *MarketRegistry) Stop() {
+	r.stopMutex.Lock()
+	defer r.stopMutex.Unlock()
+
+	if r.stop != nil {
+		close(r.stop)
+		r.stop = nil
+	}
+}
+
+/*
+Subscribe receive a MarketRegistryEvent for each change found by Refresh.  The channel is buffered; events are
+dropped rather than stalling Refresh if it fills, and counted by Dropped.
+*/
+func (r *MarketRegistry) Subscribe() chan MarketRegistryEvent {
+	newChan := make(chan MarketRegistryEvent, 100)
+
+	r.subscriberMutex.Lock()
+	r.subscribers = append(r.subscribers, newChan)
+	r.subscriberMutex.Unlock()
+
+	return newChan
+}
+
+func (r *MarketRegistry) publish(event MarketRegistryEvent) {
+	r.subscriberMutex.RLock()
+	defer r.subscriberMutex.RUnlock()
+
+	for _, ch := range r.subscribers {
+		select {
+		case ch <- event:
+		default:
+			atomic.AddUint64(&r.dropped, 1)
+		}
+	}
+}
+
+//Dropped events not delivered because a subscriber's channel was full.
+func (r *MarketRegistry) Dropped() uint64 {
+	return atomic.LoadUint64(&r.dropped)
+}
+
+//LastRefresh time of the last successful refresh.
+func (r *MarketRegistry) LastRefresh() time.Time {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.refreshed
+}
+
+//Market look up a market by name, eg "BTC-LTC".
+func (r *MarketRegistry) Market(name string) (MarketDescription, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	m, ok := r.markets[strings.ToUpper(name)]
+	return m, ok
+}
+
+//Markets every known market, active or not.
+func (r *MarketRegistry) Markets() []MarketDescription {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result := make([]MarketDescription, 0, len(r.markets))
+	for _, m := range r.markets {
+		result = append(result, m)
+	}
+
+	return result
+}
+
+//Currency look up a currency by code, eg "LTC".
+func (r *MarketRegistry) Currency(code string) (Currency, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	cur, ok := r.currencies[strings.ToUpper(code)]
+	return cur, ok
+}
+
+//ValidateMarket ensure the market exists and is active.
+func (r *MarketRegistry) ValidateMarket(market string) error {
+	m, ok := r.Market(market)
+
+	if !ok {
+		return MarketValidationError{Market: market, Reason: "unknown market"}
+	}
+
+	if !m.IsActive {
+		return MarketValidationError{Market: market, Reason: "market is not active"}
+	}
+
+	return nil
+}
+
+//ValidateOrder ensure the market is tradeable and quantity meets MinTradeSize.
+func (r *MarketRegistry) ValidateOrder(market string, quantity decimal) error {
+	if marketErr := r.ValidateMarket(market); marketErr != nil {
+		return marketErr
+	}
+
+	m, _ := r.Market(market)
+
+	if quantity < m.MinTradeSize {
+		return MarketValidationError{
+			Market: market,
+			Reason: fmt.Sprintf("quantity %.8f is below the minimum trade size of %.8f", quantity, m.MinTradeSize),
+		}
+	}
+
+	return nil
+}
+
+//ValidateWithdrawal ensure the currency exists and is active.
+func (r *MarketRegistry) ValidateWithdrawal(currency string) error {
+	cur, ok := r.Currency(currency)
+
+	if !ok {
+		return MarketValidationError{Currency: currency, Reason: "unknown currency"}
+	}
+
+	if !cur.IsActive {
+		return MarketValidationError{Currency: currency, Reason: "currency is not active"}
+	}
+
+	return nil
+}
+
+//validateOrder consult the attached registry, if any, before an order is sent.
+func (c *Client) validateOrder(market string, quantity decimal) error {
+	if c.marketRegistry == nil {
+		return nil
+	}
+
+	return c.marketRegistry.ValidateOrder(market, quantity)
+}
+
+//validateWithdrawal consult the attached registry, if any, before a withdrawal is sent.
+func (c *Client) validateWithdrawal(currency string) error {
+	if c.marketRegistry == nil {
+		return nil
+	}
+
+	return c.marketRegistry.ValidateWithdrawal(currency)
+}
@@ -0,0 +1,122 @@
+package bittrex
+
+import (
+	"fmt"
+
+	"github.com/technicalviking/bittrex2/market"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//Market typed trading pair.  Its String method returns the bittrex notation, so it can be passed to any method taking a market name.
+type Market = market.Market
+
+//NewMarket construct a Market from its base (traded, eg LTC) and quote (pricing, eg BTC) currencies.
+func NewMarket(base, quote string) Market {
+	return market.New(base, quote)
+}
+
+//ParseMarket read a market from bittrex ("BTC-LTC"), slash ("LTC/BTC"), underscore or concatenated ("LTCBTC") notation.
+func ParseMarket(symbol string) (Market, error) {
+	return market.Parse(symbol)
+}
+
+//Market typed form of MarketCurrency and BaseCurrency.
+func (md MarketDescription) Market() (Market, error) {
+	m := market.New(md.MarketCurrency, md.BaseCurrency)
+	if m.Base() == "" || m.Quote() == "" {
+		return Market{}, fmt.Errorf("markets - %q is missing a currency", md.MarketName)
+	}
+
+	return m, nil
+}
+
+//Market typed form of MarketName.
+func (ms MarketSummary) Market() (Market, error) {
+	return market.Parse(ms.MarketName)
+}
+
+//Market typed form of Exchange.
+func (od OrderDescription) Market() (Market, error) {
+	return market.Parse(od.Exchange)
+}
+
+//Market typed form of Exchange.
+func (aod AccountOrderDescription) Market() (Market, error) {
+	return market.Parse(aod.Exchange)
+}
+
+//Market typed form of Exchange.
+func (aohd AccountOrderHistoryDescription) Market() (Market, error) {
+	return market.Parse(aohd.Exchange)
+}
+
+//Market typed form of MarketName.
+func (es ExchangeState) Market() (Market, error) {
+	return market.Parse(es.MarketName)
+}
+
+/*
+The methods below are the typed forms of the most used methods taking a market name.  The string methods stay as
+they are, since changing their signatures would break every caller, and Market.String gives the bittrex notation for
+any method without a typed form.  A zero Market is passed as "", which the list methods take to mean every market.
+*/
+
+//PublicGetTickerFor PublicGetTicker for a typed market.
+func (c *Client) PublicGetTickerFor(m Market) (Ticker, error) {
+	return c.PublicGetTicker(m.String())
+}
+
+//PublicGetMarketSummaryFor PublicGetMarketSummary for a typed market.
+func (c *Client) PublicGetMarketSummaryFor(m Market) (MarketSummary, error) {
+	return c.PublicGetMarketSummary(m.String())
+}
+
+//PublicGetOrderBookFor PublicGetOrderBook for a typed market.
+func (c *Client) PublicGetOrderBookFor(m Market, orderType string) (OrderBook, error) {
+	return c.PublicGetOrderBook(m.String(), orderType)
+}
+
+//PublicGetMarketHistoryFor PublicGetMarketHistory for a typed market.
+func (c *Client) PublicGetMarketHistoryFor(m Market) ([]Trade, error) {
+	return c.PublicGetMarketHistory(m.String())
+}
+
+//PubMarketGetTicksFor PubMarketGetTicks for a typed market.
+func (c *Client) PubMarketGetTicksFor(m Market, interval string) ([]Candle, error) {
+	return c.PubMarketGetTicks(m.String(), interval)
+}
+
+//MarketBuyLimitFor MarketBuyLimit for a typed market.
+func (c *Client) MarketBuyLimitFor(m Market, quantity decimal, rate decimal) (TransactionID, error) {
+	return c.MarketBuyLimit(m.String(), quantity, rate)
+}
+
+//MarketSellLimitFor MarketSellLimit for a typed market.
+func (c *Client) MarketSellLimitFor(m Market, quantity decimal, rate decimal) (TransactionID, error) {
+	return c.MarketSellLimit(m.String(), quantity, rate)
+}
+
+//MarketGetOpenOrdersFor MarketGetOpenOrders for a typed market.
+func (c *Client) MarketGetOpenOrdersFor(m Market) ([]OrderDescription, error) {
+	return c.MarketGetOpenOrders(m.String())
+}
+
+//AccountGetOrderHistoryFor AccountGetOrderHistory for a typed market.
+func (c *Client) AccountGetOrderHistoryFor(m Market) ([]AccountOrderHistoryDescription, error) {
+	return c.AccountGetOrderHistory(m.String())
+}
+
+//QueryExchangeStateFor QueryExchangeState for a typed market.
+func (c *Client) QueryExchangeStateFor(m Market) (*socketPayloads.ExchangeState, error) {
+	return c.QueryExchangeState(m.String())
+}
+
+//SubscribeToMarketSummaryFor SubscribeToMarketSummary for a typed market.
+func (c *Client) SubscribeToMarketSummaryFor(m Market) (chan socketPayloads.Summary, error) {
+	return c.SubscribeToMarketSummary(m.String())
+}
+
+//SubscribeToExchangeFor SubscribeToExchange for a typed market.
+func (c *Client) SubscribeToExchangeFor(m Market) (chan socketPayloads.ExchangeDelta, error) {
+	return c.SubscribeToExchange(m.String())
+}
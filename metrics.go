@@ -0,0 +1,533 @@
+package bittrex
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/signalr"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//defaultLatencyBuckets upper bounds, in seconds, of the REST and hub call latency histograms.
+var defaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+//Metric results, the result label of the request and hub call counters.
+const (
+	metricResultOK       = "ok"
+	metricResultAPIError = "api_error" //the exchange answered with success false
+	metricResultError    = "error"     //timeouts, transport and parse failures
+)
+
+//MetricsConfig arguments for NewMetrics.
+type MetricsConfig struct {
+	//LatencyBuckets histogram upper bounds in seconds, ascending.  defaults to 50ms through 30s.
+	LatencyBuckets []float64
+}
+
+type latencyHistogram struct {
+	counts []uint64 //per bucket, not cumulative; the last is +Inf
+	sum    float64
+	count  uint64
+}
+
+func (h *latencyHistogram) observe(buckets []float64, seconds float64) {
+	if h.counts == nil {
+		h.counts = make([]uint64, len(buckets)+1)
+	}
+
+	i := sort.SearchFloat64s(buckets, seconds)
+	h.counts[i]++
+	h.sum += seconds
+	h.count++
+}
+
+/*
+Metrics counters and gauges for a Client, served in the Prometheus text exposition format by ServeHTTP.  Attach it
+with UseMetrics.  Covered are REST requests per endpoint, hub calls per method, socket state and reconnects, socket
+messages per event, deliveries to the Subscribe* channels with the time spent waiting on their readers, deltas no
+channel or listener took, decode errors, the attached RiskManager's stats, and, after FollowMarkets, the latest
+summary of each market.
+*/
+type Metrics struct {
+	config MetricsConfig
+
+	mutex          sync.Mutex
+	client         *Client
+	requests       map[[2]string]uint64 //endpoint, result
+	requestLatency map[string]*latencyHistogram
+	hubCalls       map[[2]string]uint64 //method, result
+	hubLatency     map[string]*latencyHistogram
+	stateChanges   map[string]uint64 //new state
+	reconnects     uint64
+	messages       map[string]uint64    //event
+	deliveries     map[[2]string]uint64 //subscription, market
+	blocked        map[string]float64   //subscription -> seconds
+	unrouted       map[string]uint64    //subscription
+	decodeErrors   map[string]uint64    //source
+
+	marketMutex    sync.RWMutex
+	markets        map[string]socketPayloads.Summary
+	marketFilter   map[string]bool
+	removeListener func()
+}
+
+//NewMetrics empty metrics.  Attach them to a client with UseMetrics.
+func NewMetrics(config MetricsConfig) *Metrics {
+	if len(config.LatencyBuckets) == 0 {
+		config.LatencyBuckets = defaultLatencyBuckets
+	}
+
+	return &Metrics{
+		config:         config,
+		requests:       make(map[[2]string]uint64),
+		requestLatency: make(map[string]*latencyHistogram),
+		hubCalls:       make(map[[2]string]uint64),
+		hubLatency:     make(map[string]*latencyHistogram),
+		stateChanges:   make(map[string]uint64),
+		messages:       make(map[string]uint64),
+		deliveries:     make(map[[2]string]uint64),
+		blocked:        make(map[string]float64),
+		unrouted:       make(map[string]uint64),
+		decodeErrors:   make(map[string]uint64),
+		markets:        make(map[string]socketPayloads.Summary),
+		removeListener: func() {},
+	}
+}
+
+//UseMetrics record the client's REST calls and socket activity in m.  The socket is instrumented now if connected, and on every later connect.
+func (c *Client) UseMetrics(m *Metrics) {
+	m.mutex.Lock()
+	m.client = c
+	m.mutex.Unlock()
+
+	c.attachMutex.Lock()
+	c.metrics = m
+	socket := c.socketClient
+	c.attachMutex.Unlock()
+
+	if socket != nil {
+		socket.SetObserver(m.observer())
+	}
+}
+
+//attachedMetrics the metrics recording the client, if any.  The Metrics methods accept a nil receiver.
+func (c *Client) attachedMetrics() *Metrics {
+	c.attachMutex.RLock()
+	defer c.attachMutex.RUnlock()
+
+	return c.metrics
+}
+
+//observer the signalr hooks feeding m.
+func (m *Metrics) observer() *signalr.Observer {
+	if m == nil {
+		return nil
+	}
+
+	return &signalr.Observer{
+		OnHubCall: func(hub, method string, duration time.Duration, err error) {
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+
+			m.hubCalls[[2]string{method, metricResult(err)}]++
+
+			histogram, ok := m.hubLatency[method]
+			if !ok {
+				histogram = &latencyHistogram{}
+				m.hubLatency[method] = histogram
+			}
+			histogram.observe(m.config.LatencyBuckets, duration.Seconds())
+		},
+		OnStateChange: func(previous, current signalr.ClientState) {
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+
+			m.stateChanges[socketStateName(current)]++
+			if current == signalr.Reconnecting {
+				m.reconnects++
+			}
+		},
+		OnDecodeError: func(err error) {
+			m.decodeError("signalr")
+		},
+	}
+}
+
+func metricResult(err error) string {
+	switch err.(type) {
+	case nil:
+		return metricResultOK
+	case APIError:
+		return metricResultAPIError
+	}
+
+	return metricResultError
+}
+
+//socketStateName the state's label value, eg "connected".
+func socketStateName(state signalr.ClientState) string {
+	return strings.ToLower(state.String())
+}
+
+func (m *Metrics) observeRequest(endpoint string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.requests[[2]string{endpoint, metricResult(err)}]++
+
+	histogram, ok := m.requestLatency[endpoint]
+	if !ok {
+		histogram = &latencyHistogram{}
+		m.requestLatency[endpoint] = histogram
+	}
+	histogram.observe(m.config.LatencyBuckets, duration.Seconds())
+}
+
+func (m *Metrics) socketMessage(event string) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	m.messages[event]++
+	m.mutex.Unlock()
+}
+
+//delivered a delta was read from a Subscribe* channel, after waiting since started for the reader.
+func (m *Metrics) delivered(subscription, market string, started time.Time) {
+	if m == nil {
+		return
+	}
+
+	waited := time.Since(started).Seconds()
+
+	m.mutex.Lock()
+	m.deliveries[[2]string{subscription, market}]++
+	m.blocked[subscription] += waited
+	m.mutex.Unlock()
+}
+
+//unroutedDelta a delta that neither a channel nor a listener took.
+func (m *Metrics) unroutedDelta(subscription string) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	m.unrouted[subscription]++
+	m.mutex.Unlock()
+}
+
+func (m *Metrics) decodeError(source string) {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	m.decodeErrors[source]++
+	m.mutex.Unlock()
+}
+
+/*
+FollowMarkets keep gauges of last, bid, ask and volume for markets from the summary deltas.  No markets follows every
+market.  The client must be attached with UseMetrics and its socket connected.
+*/
+func (m *Metrics) FollowMarkets(markets ...string) error {
+	m.mutex.Lock()
+	client := m.client
+	m.mutex.Unlock()
+
+	if client == nil {
+		return fmt.Errorf("metrics - attach to a client with UseMetrics before following markets")
+	}
+
+	filter := make(map[string]bool, len(markets))
+	for _, market := range markets {
+		filter[strings.ToUpper(market)] = true
+	}
+
+	m.marketMutex.Lock()
+	m.marketFilter = filter
+	m.marketMutex.Unlock()
+
+	remove, listenErr := client.addSummaryListener(m.summaryDelta)
+	if listenErr != nil {
+		return listenErr
+	}
+
+	m.marketMutex.Lock()
+	m.removeListener()
+	m.removeListener = remove
+	m.marketMutex.Unlock()
+
+	return nil
+}
+
+//StopMarkets stop following the summary deltas and drop the market gauges.
+func (m *Metrics) StopMarkets() {
+	m.marketMutex.Lock()
+	remove := m.removeListener
+	m.removeListener = func() {}
+	m.markets = make(map[string]socketPayloads.Summary)
+	m.marketMutex.Unlock()
+
+	//outside the mutex; summaryDelta takes it, and removing waits on running listeners.
+	remove()
+}
+
+func (m *Metrics) summaryDelta(summary socketPayloads.Summary) {
+	market := strings.ToUpper(summary.MarketName)
+
+	m.marketMutex.Lock()
+	defer m.marketMutex.Unlock()
+
+	if len(m.marketFilter) > 0 && !m.marketFilter[market] {
+		return
+	}
+
+	m.markets[market] = summary
+}
+
+//ServeHTTP write every metric in the Prometheus text exposition format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+type metricSample struct {
+	suffix string
+	labels []string //name, value pairs
+	value  float64
+}
+
+type metricWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (mw *metricWriter) family(name, kind, help string, samples []metricSample) {
+	if len(samples) == 0 || mw.err != nil {
+		return
+	}
+
+	fmt.Fprintf(mw.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+
+	for _, sample := range samples {
+		mw.w.WriteString(name + sample.suffix)
+
+		if len(sample.labels) > 0 {
+			pairs := make([]string, 0, len(sample.labels)/2)
+			for i := 0; i+1 < len(sample.labels); i += 2 {
+				pairs = append(pairs, sample.labels[i]+`="`+escapeLabel(sample.labels[i+1])+`"`)
+			}
+			mw.w.WriteString("{" + strings.Join(pairs, ",") + "}")
+		}
+
+		mw.w.WriteString(" " + formatMetricValue(sample.value) + "\n")
+	}
+}
+
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+func formatMetricValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func pairSamples(counts map[[2]string]uint64, first, second string) []metricSample {
+	samples := make([]metricSample, 0, len(counts))
+	for key, count := range counts {
+		samples = append(samples, metricSample{labels: []string{first, key[0], second, key[1]}, value: float64(count)})
+	}
+
+	return sortSamples(samples)
+}
+
+func labelSamples(counts map[string]uint64, label string) []metricSample {
+	samples := make([]metricSample, 0, len(counts))
+	for key, count := range counts {
+		samples = append(samples, metricSample{labels: []string{label, key}, value: float64(count)})
+	}
+
+	return sortSamples(samples)
+}
+
+func histogramSamples(histograms map[string]*latencyHistogram, label string, buckets []float64) []metricSample {
+	var names []string
+	for name := range histograms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var samples []metricSample
+	for _, name := range names {
+		histogram := histograms[name]
+
+		var cumulative uint64
+		for i, count := range histogram.counts {
+			cumulative += count
+
+			bound := "+Inf"
+			if i < len(buckets) {
+				bound = formatMetricValue(buckets[i])
+			}
+
+			samples = append(samples, metricSample{suffix: "_bucket", labels: []string{label, name, "le", bound}, value: float64(cumulative)})
+		}
+
+		samples = append(samples,
+			metricSample{suffix: "_sum", labels: []string{label, name}, value: histogram.sum},
+			metricSample{suffix: "_count", labels: []string{label, name}, value: float64(histogram.count)},
+		)
+	}
+
+	return samples
+}
+
+//sortSamples stable output order, by label values.
+func sortSamples(samples []metricSample) []metricSample {
+	sort.SliceStable(samples, func(i, j int) bool {
+		return strings.Join(samples[i].labels, "\x00") < strings.Join(samples[j].labels, "\x00")
+	})
+
+	return samples
+}
+
+//WriteTo write every metric to w in the Prometheus text exposition format.
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	mw := &metricWriter{w: bufio.NewWriter(counter)}
+
+	m.mutex.Lock()
+	client := m.client
+	buckets := m.config.LatencyBuckets
+
+	mw.family("bittrex_rest_requests_total", "counter", "REST requests by endpoint and result.", pairSamples(m.requests, "endpoint", "result"))
+	mw.family("bittrex_rest_request_duration_seconds", "histogram", "REST request latency by endpoint.", histogramSamples(m.requestLatency, "endpoint", buckets))
+	mw.family("bittrex_hub_calls_total", "counter", "SignalR hub calls by method and result.", pairSamples(m.hubCalls, "method", "result"))
+	mw.family("bittrex_hub_call_duration_seconds", "histogram", "SignalR hub call latency by method.", histogramSamples(m.hubLatency, "method", buckets))
+	mw.family("bittrex_socket_state_changes_total", "counter", "Socket state changes by new state.", labelSamples(m.stateChanges, "state"))
+	mw.family("bittrex_socket_reconnects_total", "counter", "Socket reconnect attempts.", []metricSample{{value: float64(m.reconnects)}})
+	mw.family("bittrex_socket_messages_total", "counter", "Socket messages received by event.", labelSamples(m.messages, "event"))
+	mw.family("bittrex_subscription_deliveries_total", "counter", "Deltas read from a Subscribe channel, by subscription and market.", pairSamples(m.deliveries, "subscription", "market"))
+
+	blocked := make([]metricSample, 0, len(m.blocked))
+	for subscription, seconds := range m.blocked {
+		blocked = append(blocked, metricSample{labels: []string{"subscription", subscription}, value: seconds})
+	}
+	mw.family("bittrex_subscription_blocked_seconds_total", "counter", "Time the socket spent waiting on Subscribe channel readers.", sortSamples(blocked))
+
+	mw.family("bittrex_subscription_unrouted_total", "counter", "Deltas dropped because no channel or listener took them.", labelSamples(m.unrouted, "subscription"))
+	mw.family("bittrex_decode_errors_total", "counter", "Socket payloads that could not be decoded, by source.", labelSamples(m.decodeErrors, "source"))
+	m.mutex.Unlock()
+
+	if client != nil {
+		if socket := client.socket(); socket != nil {
+			state := socket.State()
+			var states []metricSample
+			for _, candidate := range []signalr.ClientState{signalr.Disconnected, signalr.Connecting, signalr.Reconnecting, signalr.Connected} {
+				value := 0.0
+				if candidate == state {
+					value = 1
+				}
+				states = append(states, metricSample{labels: []string{"state", socketStateName(candidate)}, value: value})
+			}
+			mw.family("bittrex_socket_state", "gauge", "1 for the socket's current state.", states)
+		}
+
+		if r := client.attachedRiskManager(); r != nil {
+			m.writeRiskStats(mw, r.Stats())
+		}
+	}
+
+	m.writeMarkets(mw)
+
+	if mw.err == nil {
+		mw.err = mw.w.Flush()
+	}
+
+	if mw.err == nil {
+		mw.err = counter.err
+	}
+
+	return counter.written, mw.err
+}
+
+func (m *Metrics) writeRiskStats(mw *metricWriter, stats RiskStats) {
+	violations := make([]metricSample, 0, len(stats.Violations))
+	for rule, count := range stats.Violations {
+		violations = append(violations, metricSample{labels: []string{"rule", string(rule)}, value: float64(count)})
+	}
+
+	mw.family("bittrex_risk_checks_total", "counter", "Orders checked by the risk manager.", []metricSample{{value: float64(stats.Checks)}})
+	mw.family("bittrex_risk_violations_total", "counter", "Orders rejected by the risk manager, by rule.", sortSamples(violations))
+	mw.family("bittrex_risk_open_orders", "gauge", "Open orders tracked by the risk manager.", []metricSample{{value: float64(stats.OpenOrders)}})
+	mw.family("bittrex_risk_daily_pnl", "gauge", "Realized PnL recorded today.", []metricSample{{value: stats.DailyPnL}})
+}
+
+func (m *Metrics) writeMarkets(mw *metricWriter) {
+	m.marketMutex.RLock()
+	defer m.marketMutex.RUnlock()
+
+	if len(m.markets) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(m.markets))
+	for name := range m.markets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	gauge := func(value func(socketPayloads.Summary) decimal) []metricSample {
+		samples := make([]metricSample, 0, len(names))
+		for _, name := range names {
+			samples = append(samples, metricSample{labels: []string{"market", name}, value: value(m.markets[name])})
+		}
+		return samples
+	}
+
+	mw.family("bittrex_market_last", "gauge", "Last trade price.", gauge(func(s socketPayloads.Summary) decimal { return s.Last }))
+	mw.family("bittrex_market_bid", "gauge", "Best bid.", gauge(func(s socketPayloads.Summary) decimal { return s.Bid }))
+	mw.family("bittrex_market_ask", "gauge", "Best ask.", gauge(func(s socketPayloads.Summary) decimal { return s.Ask }))
+	mw.family("bittrex_market_base_volume", "gauge", "24 hour volume in the base currency.", gauge(func(s socketPayloads.Summary) decimal { return s.BaseVolume }))
+	mw.family("bittrex_market_volume", "gauge", "24 hour volume in the market currency.", gauge(func(s socketPayloads.Summary) decimal { return s.Volume }))
+}
+
+type countingWriter struct {
+	w       io.Writer
+	written int64
+	err     error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.written += int64(n)
+	if err != nil && cw.err == nil {
+		cw.err = err
+	}
+
+	return n, err
+}
@@ -0,0 +1,466 @@
+package bittrex
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//archiveOrderKind record kind for orders; deposits and withdrawals use their TransferKind.
+const archiveOrderKind = "ORDER"
+
+//archiveRecord one line of the archive file.
+type archiveRecord struct {
+	Kind     string
+	Key      string
+	Archived time.Time
+	Order    *AccountOrderHistoryDescription `json:",omitempty"`
+	Transfer *TransactionHistoryDescription  `json:",omitempty"`
+}
+
+//ArchiveQuery filter for the archive queries.  Zero fields match everything.
+type ArchiveQuery struct {
+	//Market orders only, eg BTC-LTC.
+	Market string
+	//Currency deposits and withdrawals only.
+	Currency string
+	//Side orders only.
+	Side OrderSide
+	//From inclusive.
+	From time.Time
+	//To exclusive.
+	To time.Time
+}
+
+func (q ArchiveQuery) inRange(at time.Time) bool {
+	if !q.From.IsZero() && at.Before(q.From) {
+		return false
+	}
+
+	if !q.To.IsZero() && !at.Before(q.To) {
+		return false
+	}
+
+	return true
+}
+
+/*
+OrderArchive local, append-only copy of the order, deposit and withdrawal histories, which Bittrex only returns for a
+limited time and without pagination.  Each Sync appends the records that are new or have changed; orders are keyed by
+OrderUUID and transfers by PaymentUUID.  Orders closed while following the order deltas are archived as they close, and
+superseded by the history's copy once it appears there.  The file is newline delimited JSON; the last line for a key
+wins.
+*/
+type OrderArchive struct {
+	client *Client
+	path   string
+
+	mutex       sync.RWMutex
+	file        *os.File
+	orders      map[string]AccountOrderHistoryDescription
+	deposits    map[string]TransactionHistoryDescription
+	withdrawals map[string]TransactionHistoryDescription
+
+	stopMutex sync.Mutex
+	stop      chan struct{}
+
+	listenerMutex  sync.Mutex
+	removeListener func()
+}
+
+//OpenOrderArchive load the archive at path, creating it if it doesn't exist.  Call Sync to bring it up to date.
+func OpenOrderArchive(c *Client, path string) (*OrderArchive, error) {
+	a := &OrderArchive{
+		client:         c,
+		path:           path,
+		orders:         make(map[string]AccountOrderHistoryDescription),
+		deposits:       make(map[string]TransactionHistoryDescription),
+		withdrawals:    make(map[string]TransactionHistoryDescription),
+		removeListener: func() {},
+	}
+
+	file, openErr := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if openErr != nil {
+		return nil, openErr
+	}
+
+	if loadErr := a.load(file); loadErr != nil {
+		file.Close()
+		return nil, loadErr
+	}
+
+	a.file = file
+
+	return a, nil
+}
+
+//load read every record.  A malformed last line is a write cut short by a crash, and is dropped.
+func (a *OrderArchive) load(file *os.File) error {
+	reader := bufio.NewReader(file)
+	line := 0
+	var size int64
+
+	for {
+		raw, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+
+		if len(raw) == 0 {
+			break
+		}
+
+		line++
+		complete := raw[len(raw)-1] == '\n'
+
+		var record archiveRecord
+		if parseErr := json.Unmarshal(raw, &record); parseErr != nil {
+			if !complete {
+				//truncate it so the next append starts on a fresh line.
+				return file.Truncate(size)
+			}
+			return fmt.Errorf("order archive - %s line %d: %s", a.path, line, parseErr.Error())
+		}
+
+		if !complete {
+			//parsed, but the newline never made it.
+			if _, writeErr := file.Write([]byte("\n")); writeErr != nil {
+				return writeErr
+			}
+		}
+
+		a.index(record)
+		size += int64(len(raw))
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (a *OrderArchive) index(record archiveRecord) {
+	switch {
+	case record.Kind == archiveOrderKind && record.Order != nil:
+		a.orders[record.Key] = *record.Order
+	case record.Kind == string(TransferDeposit) && record.Transfer != nil:
+		a.deposits[record.Key] = *record.Transfer
+	case record.Kind == string(TransferWithdrawal) && record.Transfer != nil:
+		a.withdrawals[record.Key] = *record.Transfer
+	}
+}
+
+//appendLocked write record if it is new or differs from the archived copy.  must be called with the mutex held.
+func (a *OrderArchive) appendLocked(record archiveRecord) (bool, error) {
+	if a.file == nil {
+		return false, fmt.Errorf("order archive - %s is closed", a.path)
+	}
+
+	var previous interface{}
+	var current interface{}
+	var known bool
+
+	switch record.Kind {
+	case archiveOrderKind:
+		previous, known = a.orders[record.Key]
+		current = *record.Order
+	case string(TransferDeposit):
+		previous, known = a.deposits[record.Key]
+		current = *record.Transfer
+	case string(TransferWithdrawal):
+		previous, known = a.withdrawals[record.Key]
+		current = *record.Transfer
+	}
+
+	if known && reflect.DeepEqual(previous, current) {
+		return false, nil
+	}
+
+	record.Archived = time.Now().UTC()
+
+	raw, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		return false, marshalErr
+	}
+
+	if _, writeErr := a.file.Write(append(raw, '\n')); writeErr != nil {
+		return false, writeErr
+	}
+
+	a.index(record)
+
+	return true, nil
+}
+
+/*
+Sync pull the order, deposit and withdrawal histories and archive anything new or changed.
+Returns the number of records appended.
+*/
+func (a *OrderArchive) Sync() (int, error) {
+	orders, ordersErr := a.client.AccountGetOrderHistory("")
+	if ordersErr != nil {
+		return 0, fmt.Errorf("order archive - order history: %s", ordersErr.Error())
+	}
+
+	deposits, depositErr := a.client.AccountGetDepositHistory("")
+	if depositErr != nil {
+		return 0, fmt.Errorf("order archive - deposit history: %s", depositErr.Error())
+	}
+
+	withdrawals, withdrawalErr := a.client.AccountGetWithdrawalHistory("")
+	if withdrawalErr != nil {
+		return 0, fmt.Errorf("order archive - withdrawal history: %s", withdrawalErr.Error())
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	appended := 0
+
+	add := func(record archiveRecord) error {
+		if record.Key == "" {
+			return nil
+		}
+
+		added, appendErr := a.appendLocked(record)
+		if added {
+			appended++
+		}
+
+		return appendErr
+	}
+
+	for i := range orders {
+		if appendErr := add(archiveRecord{Kind: archiveOrderKind, Key: orders[i].OrderUUID, Order: &orders[i]}); appendErr != nil {
+			return appended, appendErr
+		}
+	}
+
+	for i := range deposits {
+		record := archiveRecord{Kind: string(TransferDeposit), Key: transferKey(TransferDeposit, deposits[i]), Transfer: &deposits[i]}
+		if appendErr := add(record); appendErr != nil {
+			return appended, appendErr
+		}
+	}
+
+	for i := range withdrawals {
+		record := archiveRecord{Kind: string(TransferWithdrawal), Key: transferKey(TransferWithdrawal, withdrawals[i]), Transfer: &withdrawals[i]}
+		if appendErr := add(record); appendErr != nil {
+			return appended, appendErr
+		}
+	}
+
+	return appended, nil
+}
+
+/*
+AutoSync sync the archive every interval until Stop is called.
+Sync failures are sent on the returned channel; the archive keeps what it already has.
+*/
+func (a *OrderArchive) AutoSync(interval time.Duration) chan error {
+	a.stopMutex.Lock()
+	if a.stop != nil {
+		close(a.stop)
+	}
+	stop := make(chan struct{})
+	a.stop = stop
+	a.stopMutex.Unlock()
+
+	errChan := make(chan error, 5)
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if _, syncErr := a.Sync(); syncErr != nil {
+					select {
+					case errChan <- syncErr:
+					default:
+					}
+				}
+			}
+		}
+	}()
+
+	return errChan
+}
+
+//Stop end AutoSync.
+func (a *OrderArchive) Stop() {
+	a.stopMutex.Lock()
+	defer a.stopMutex.Unlock()
+
+	if a.stop != nil {
+		close(a.stop)
+		a.stop = nil
+	}
+}
+
+//Follow archive orders as the order deltas report them filled or cancelled.
+func (a *OrderArchive) Follow() {
+	a.listenerMutex.Lock()
+	defer a.listenerMutex.Unlock()
+
+	a.removeListener()
+	a.removeListener = a.client.addOrderListener(a.orderDelta)
+}
+
+//Close stop syncing and following, and close the file.
+func (a *OrderArchive) Close() error {
+	a.Stop()
+
+	a.listenerMutex.Lock()
+	a.removeListener()
+	a.removeListener = func() {}
+	a.listenerMutex.Unlock()
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.file == nil {
+		return nil
+	}
+
+	closeErr := a.file.Close()
+	a.file = nil
+
+	return closeErr
+}
+
+func (a *OrderArchive) orderDelta(delta socketPayloads.OrderResponse) {
+	if delta.Type != socketPayloads.OrderDeltaFill && delta.Type != socketPayloads.OrderDeltaCancel {
+		return
+	}
+
+	order := delta.Order
+	if order.OrderUUID == "" {
+		return
+	}
+
+	history := AccountOrderHistoryDescription{
+		OrderUUID:         order.OrderUUID,
+		Exchange:          order.Exchange,
+		TimeStamp:         Timestamp(order.Opened.Get().UTC()),
+		OrderType:         order.OrderType,
+		Limit:             order.Limit,
+		Quantity:          order.Quantity,
+		QuantityRemaining: order.QuantityRemaining,
+		Commission:        order.CommissionPaid,
+		Price:             order.Price,
+		PricePerUnit:      order.PricePerUnit,
+		IsConditional:     order.IsConditional,
+		Condition:         order.Condition,
+		ImmediateOrCancel: order.ImmediateOrCancel,
+	}
+
+	if order.IsConditional {
+		history.ConditionTarget = strconv.FormatFloat(order.ConditionTarget, 'f', -1, 64)
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	//the history's copy is authoritative; don't let a delta replay overwrite it.
+	if _, known := a.orders[order.OrderUUID]; known {
+		return
+	}
+
+	//listeners can't return errors; the next Sync retries from the history.
+	a.appendLocked(archiveRecord{Kind: archiveOrderKind, Key: order.OrderUUID, Order: &history})
+}
+
+//Orders archived orders matching query, oldest first.
+func (a *OrderArchive) Orders(query ArchiveQuery) []AccountOrderHistoryDescription {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	var result []AccountOrderHistoryDescription
+
+	for _, order := range a.orders {
+		if query.Market != "" && !strings.EqualFold(query.Market, order.Exchange) {
+			continue
+		}
+
+		if query.Side != "" {
+			side := OrderSideSell
+			if strings.Contains(strings.ToUpper(order.OrderType), "BUY") {
+				side = OrderSideBuy
+			}
+
+			if side != query.Side {
+				continue
+			}
+		}
+
+		if !query.inRange(time.Time(order.TimeStamp)) {
+			continue
+		}
+
+		result = append(result, order)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return time.Time(result[i].TimeStamp).Before(time.Time(result[j].TimeStamp))
+	})
+
+	return result
+}
+
+//Deposits archived deposits matching query, oldest first.
+func (a *OrderArchive) Deposits(query ArchiveQuery) []TransactionHistoryDescription {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return queryTransfers(a.deposits, query)
+}
+
+//Withdrawals archived withdrawals matching query, oldest first.
+func (a *OrderArchive) Withdrawals(query ArchiveQuery) []TransactionHistoryDescription {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return queryTransfers(a.withdrawals, query)
+}
+
+func queryTransfers(transfers map[string]TransactionHistoryDescription, query ArchiveQuery) []TransactionHistoryDescription {
+	var result []TransactionHistoryDescription
+
+	for _, transfer := range transfers {
+		if query.Currency != "" && !strings.EqualFold(query.Currency, transfer.Currency) {
+			continue
+		}
+
+		if !query.inRange(time.Time(transfer.Opened)) {
+			continue
+		}
+
+		result = append(result, transfer)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return time.Time(result[i].Opened).Before(time.Time(result[j].Opened))
+	})
+
+	return result
+}
+
+//Ledger BuildLedger from the archive rather than the live histories, so trades past the retention window are included.
+func (a *OrderArchive) Ledger(year int, method CostMethod) ([]LedgerRow, error) {
+	return a.client.buildLedger(a.Orders(ArchiveQuery{}), a.Deposits(ArchiveQuery{}), a.Withdrawals(ArchiveQuery{}), year, method)
+}
@@ -0,0 +1,698 @@
+package bittrex
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//quantities closer than this are considered equal.  one satoshi of the traded currency.
+const quantityEpsilon decimal = 0.00000001
+
+//orderGroupCancelRetry least time between attempts to cancel the take profit of a triggered group.
+const orderGroupCancelRetry time.Duration = 5 * time.Second
+
+//OrderGroupState lifecycle of an order group.
+type OrderGroupState string
+
+//Order Group States
+const (
+	OrderGroupActive    OrderGroupState = "ACTIVE"
+	OrderGroupCompleted OrderGroupState = "COMPLETED"
+	OrderGroupCancelled OrderGroupState = "CANCELLED"
+	OrderGroupFailed    OrderGroupState = "FAILED"
+)
+
+//OrderGroupEventType kind of change reported by an OrderGroupManager.
+type OrderGroupEventType int
+
+//Order Group Event Types
+const (
+	OrderGroupPlaced OrderGroupEventType = iota
+	OrderGroupLegFilled
+	OrderGroupDone
+	OrderGroupError
+	//OrderGroupStopTriggered the price reached the stop trigger; the take profit is being replaced by the stop loss.
+	OrderGroupStopTriggered
+)
+
+//OrderGroupEvent change notification for an order group.  Group is a copy taken when the event was raised.
+type OrderGroupEvent struct {
+	Type  OrderGroupEventType
+	Group OrderGroup
+	Err   error
+}
+
+//OrderGroupLeg one side of an order group.  The stop loss has no OrderID until it has been triggered.
+type OrderGroupLeg struct {
+	OrderID         string
+	Rate            decimal
+	Condition       OrderCondition
+	ConditionTarget decimal
+
+	//Quantity size of the leg's order.
+	Quantity decimal
+	//CurrentFilled filled on the leg's order.
+	CurrentFilled decimal
+	//Triggered when the price reached ConditionTarget, for the stop loss.  zero until then.
+	Triggered time.Time
+}
+
+//Filled total filled on this leg.
+func (l OrderGroupLeg) Filled() decimal {
+	return l.CurrentFilled
+}
+
+//OrderGroup a take profit and stop loss protecting one position.  whichever fills first cancels the other.
+type OrderGroup struct {
+	ID       string
+	Market   string
+	Side     OrderSide //side of both exit orders, SELL to close a long position.
+	Quantity decimal
+	State    OrderGroupState
+
+	TakeProfit OrderGroupLeg
+	StopLoss   OrderGroupLeg
+
+	Created time.Time
+	Updated time.Time
+	Reason  string //why the group left the active state.
+}
+
+//Remaining quantity not yet filled by either leg.
+func (g OrderGroup) Remaining() decimal {
+	return g.Quantity - g.TakeProfit.Filled() - g.StopLoss.Filled()
+}
+
+/*
+BracketRequest arguments for PlaceBracket.
+For a SELL group the take profit rests above the market.  When the last price falls to StopTrigger the take profit
+is cancelled and whatever it hasn't filled is sold with a limit order at StopRate.  A BUY group (closing a short)
+mirrors that.
+*/
+type BracketRequest struct {
+	Market         string
+	Side           OrderSide
+	Quantity       decimal
+	TakeProfitRate decimal
+	StopTrigger    decimal
+	StopRate       decimal
+}
+
+//OrderGroupStore persistence for order groups, so they survive a restart.
+type OrderGroupStore interface {
+	LoadOrderGroups() ([]OrderGroup, error)
+	SaveOrderGroups([]OrderGroup) error
+}
+
+//FileOrderGroupStore OrderGroupStore writing a single json file.
+type FileOrderGroupStore struct {
+	Path string
+}
+
+//LoadOrderGroups implement OrderGroupStore.  A missing file is an empty store.
+func (s FileOrderGroupStore) LoadOrderGroups() ([]OrderGroup, error) {
+	var groups []OrderGroup
+	return groups, loadJSONFile(s.Path, &groups)
+}
+
+//SaveOrderGroups implement OrderGroupStore
+func (s FileOrderGroupStore) SaveOrderGroups(groups []OrderGroup) error {
+	return saveJSONFile(s.Path, groups)
+}
+
+type orderGroupRequest struct {
+	bracket *BracketRequest
+	cancel  string
+	reply   chan orderGroupReply
+}
+
+type orderGroupReply struct {
+	group OrderGroup
+	err   error
+}
+
+/*
+OrderGroupManager places and supervises order groups (OCO / bracket orders), following the order deltas from the
+authenticated socket.  Only one leg is on the exchange at a time, so the position is reserved once: the take profit
+rests on the book, and the stop loss is watched client side against the market summary deltas.  When the last price
+reaches the stop trigger the take profit is cancelled and the stop loss placed for what is left, so stops only fire
+while a manager is running with its socket connected.
+
+Every exchange call is made from the manager's own goroutine, never while holding the lock Groups reads under, and
+never from the socket's.  If the order deltas back up, the manager reads its orders back from the exchange instead.
+*/
+type OrderGroupManager struct {
+	client *Client
+	store  OrderGroupStore
+
+	//groups, byOrder and cancelled belong to the manager's goroutine.
+	groups    map[string]*OrderGroup
+	byOrder   map[string]string    //order id -> group id
+	cancelled map[string]time.Time //group id -> last attempt to cancel a triggered group's take profit
+
+	mutex     sync.Mutex
+	published map[string]OrderGroup //copies for Groups, Group and the store
+
+	priceMutex  sync.Mutex
+	prices      map[string]decimal //market -> last price
+	priceSignal chan struct{}
+
+	events   chan OrderGroupEvent
+	requests chan orderGroupRequest
+	deltas   chan socketPayloads.OrderResponse
+	overflow chan struct{}
+	done     chan struct{}
+
+	removeListeners []func()
+	closeOnce       sync.Once
+}
+
+/*
+NewOrderGroupManager construct a manager, restoring any active groups from store and reconciling them with
+account/getorder so fills that happened while the process was down are acted on.  store may be nil.
+*/
+func NewOrderGroupManager(c *Client, store OrderGroupStore) (*OrderGroupManager, error) {
+	m := &OrderGroupManager{
+		client:      c,
+		store:       store,
+		groups:      make(map[string]*OrderGroup),
+		byOrder:     make(map[string]string),
+		cancelled:   make(map[string]time.Time),
+		published:   make(map[string]OrderGroup),
+		prices:      make(map[string]decimal),
+		priceSignal: make(chan struct{}, 1),
+		events:      make(chan OrderGroupEvent, 100),
+		requests:    make(chan orderGroupRequest),
+		deltas:      make(chan socketPayloads.OrderResponse, 100),
+		overflow:    make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+
+	if store != nil {
+		saved, loadErr := store.LoadOrderGroups()
+		if loadErr != nil {
+			return nil, loadErr
+		}
+
+		for i := range saved {
+			group := saved[i]
+			m.groups[group.ID] = &group
+			m.published[group.ID] = group
+			m.index(&group)
+		}
+	}
+
+	removeSummaries, listenErr := c.addSummaryListener(func(summary socketPayloads.Summary) {
+		m.priceMutex.Lock()
+		m.prices[summary.MarketName] = summary.Last
+		m.priceMutex.Unlock()
+
+		select {
+		case m.priceSignal <- struct{}{}:
+		default:
+		}
+	})
+	if listenErr != nil {
+		return nil, listenErr
+	}
+
+	removeOrders := c.addOrderListener(func(order socketPayloads.OrderResponse) {
+		select {
+		case m.deltas <- order:
+		default:
+			//the manager is behind; it reads its orders back from the exchange rather than stall the socket.
+			select {
+			case m.overflow <- struct{}{}:
+			default:
+			}
+		}
+	})
+
+	m.removeListeners = []func(){removeSummaries, removeOrders}
+
+	m.reconcile()
+
+	go m.run()
+
+	return m, nil
+}
+
+//Events group changes.  The channel is buffered; events are dropped rather than stalling the manager if it fills.
+func (m *OrderGroupManager) Events() chan OrderGroupEvent {
+	return m.events
+}
+
+//Close stop following order deltas and prices.  Live orders are left as they are, and untriggered stops stop watching.
+func (m *OrderGroupManager) Close() {
+	m.closeOnce.Do(func() {
+		close(m.done)
+		for _, remove := range m.removeListeners {
+			remove()
+		}
+	})
+}
+
+//Groups copies of every known group.
+func (m *OrderGroupManager) Groups() []OrderGroup {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	result := make([]OrderGroup, 0, len(m.published))
+	for _, group := range m.published {
+		result = append(result, group)
+	}
+
+	return result
+}
+
+//Group copy of a single group.
+func (m *OrderGroupManager) Group(id string) (OrderGroup, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	group, ok := m.published[id]
+	return group, ok
+}
+
+//PlaceBracket place a take profit and start watching the stop loss for one position.
+func (m *OrderGroupManager) PlaceBracket(request BracketRequest) (OrderGroup, error) {
+	if request.Side != OrderSideBuy && request.Side != OrderSideSell {
+		return OrderGroup{}, OrderValidationError{"Side", fmt.Sprintf("unknown side %q", request.Side)}
+	}
+
+	if request.StopTrigger <= 0 || request.StopRate <= 0 {
+		return OrderGroup{}, OrderValidationError{"StopTrigger", "stop trigger and stop rate must be greater than zero"}
+	}
+
+	return m.call(orderGroupRequest{bracket: &request})
+}
+
+//Cancel cancel whichever leg of a group is live, and stop watching its stop.
+func (m *OrderGroupManager) Cancel(id string) error {
+	_, cancelErr := m.call(orderGroupRequest{cancel: id})
+	return cancelErr
+}
+
+//call hand request to the manager's goroutine and wait for it to be carried out.
+func (m *OrderGroupManager) call(request orderGroupRequest) (OrderGroup, error) {
+	request.reply = make(chan orderGroupReply, 1)
+
+	select {
+	case m.requests <- request:
+	case <-m.done:
+		return OrderGroup{}, fmt.Errorf("order group manager is closed")
+	}
+
+	reply := <-request.reply
+	return reply.group, reply.err
+}
+
+func (m *OrderGroupManager) run() {
+	for {
+		select {
+		case <-m.done:
+			return
+		case request := <-m.requests:
+			var reply orderGroupReply
+			if request.bracket != nil {
+				reply.group, reply.err = m.placeBracket(*request.bracket)
+			} else {
+				reply.err = m.cancel(request.cancel)
+			}
+			request.reply <- reply
+		case delta := <-m.deltas:
+			m.handleDelta(delta.Type, delta.Order.OrderUUID, delta.Order.Quantity, delta.Order.QuantityRemaining)
+		case <-m.overflow:
+			m.reconcile()
+		case <-m.priceSignal:
+			m.checkTriggers()
+		}
+	}
+}
+
+func (m *OrderGroupManager) placeBracket(request BracketRequest) (OrderGroup, error) {
+	var stopCondition OrderCondition = OrderConditionLT
+	if request.Side == OrderSideBuy {
+		stopCondition = OrderConditionGT
+	}
+
+	now := time.Now()
+	group := &OrderGroup{
+		ID:       newLocalID(),
+		Market:   request.Market,
+		Side:     request.Side,
+		Quantity: request.Quantity,
+		State:    OrderGroupActive,
+		TakeProfit: OrderGroupLeg{
+			Rate:      request.TakeProfitRate,
+			Condition: OrderConditionNone,
+		},
+		StopLoss: OrderGroupLeg{
+			Rate:            request.StopRate,
+			Condition:       stopCondition,
+			ConditionTarget: request.StopTrigger,
+		},
+		Created: now,
+		Updated: now,
+	}
+
+	//deltas for the new order wait in the queue until the group is indexed.
+	if placeErr := m.placeLeg(group, &group.TakeProfit, request.Quantity); placeErr != nil {
+		return OrderGroup{}, placeErr
+	}
+
+	m.groups[group.ID] = group
+	m.commit(group)
+	m.emit(OrderGroupPlaced, group, nil)
+
+	//the price may already be past the trigger.
+	m.checkTriggers()
+
+	return *m.groups[group.ID], nil
+}
+
+func (m *OrderGroupManager) cancel(id string) error {
+	group, ok := m.groups[id]
+	if !ok {
+		return fmt.Errorf("order group %s not found", id)
+	}
+
+	if group.State != OrderGroupActive {
+		return fmt.Errorf("order group %s is %s", id, group.State)
+	}
+
+	//until the stop loss is placed, the take profit is the live leg, triggered or not.
+	var cancelErr error
+	if group.StopLoss.OrderID == "" {
+		cancelErr = m.cancelOrder(group.TakeProfit.OrderID)
+	} else {
+		cancelErr = m.cancelOrder(group.StopLoss.OrderID)
+	}
+
+	m.finish(group, OrderGroupCancelled, "cancelled by caller")
+
+	return cancelErr
+}
+
+/*
+checkTriggers fire the stops of active groups whose market's last price has reached the trigger, and try again to cancel
+the take profit of triggered groups still waiting on it.
+*/
+func (m *OrderGroupManager) checkTriggers() {
+	m.priceMutex.Lock()
+	prices := make(map[string]decimal, len(m.prices))
+	for market, price := range m.prices {
+		prices[market] = price
+	}
+	m.priceMutex.Unlock()
+
+	for _, group := range m.groups {
+		if group.State != OrderGroupActive {
+			continue
+		}
+
+		if !group.StopLoss.Triggered.IsZero() {
+			if m.awaitingCancel(group) && time.Since(m.cancelled[group.ID]) >= orderGroupCancelRetry {
+				m.stopTakeProfit(group)
+			}
+			continue
+		}
+
+		price, ok := prices[group.Market]
+		if !ok || price <= 0 {
+			continue
+		}
+
+		target := group.StopLoss.ConditionTarget
+		if (group.Side == OrderSideSell && price <= target) || (group.Side == OrderSideBuy && price >= target) {
+			m.triggerStop(group)
+		}
+	}
+}
+
+//triggerStop cancel the take profit.  The stop loss is placed once the cancel is confirmed and its fills are known.
+func (m *OrderGroupManager) triggerStop(group *OrderGroup) {
+	group.StopLoss.Triggered = time.Now()
+	group.Updated = group.StopLoss.Triggered
+	m.commit(group)
+	m.emit(OrderGroupStopTriggered, group, nil)
+
+	m.stopTakeProfit(group)
+}
+
+/*
+stopTakeProfit cancel a triggered group's take profit and read it back.  If it is still open, because the cancel failed
+or hasn't been carried out yet, checkTriggers and reconcile try again until it is cancelled or filled.
+*/
+func (m *OrderGroupManager) stopTakeProfit(group *OrderGroup) {
+	m.cancelled[group.ID] = time.Now()
+
+	if cancelErr := m.cancelOrder(group.TakeProfit.OrderID); cancelErr != nil {
+		//the take profit may have filled meanwhile; reading it back settles the group either way.
+		m.emit(OrderGroupError, group, cancelErr)
+	}
+
+	m.readBack(group.TakeProfit.OrderID)
+
+	if !m.awaitingCancel(group) {
+		delete(m.cancelled, group.ID)
+	}
+}
+
+//awaitingCancel true for a triggered group whose take profit hasn't been cancelled yet.
+func (m *OrderGroupManager) awaitingCancel(group *OrderGroup) bool {
+	return group.State == OrderGroupActive && !group.StopLoss.Triggered.IsZero() && group.StopLoss.OrderID == ""
+}
+
+//reconcile bring active groups up to date with the exchange.
+func (m *OrderGroupManager) reconcile() {
+	for _, group := range m.groups {
+		if group.State != OrderGroupActive {
+			continue
+		}
+
+		m.readBack(group.TakeProfit.OrderID)
+		m.readBack(group.StopLoss.OrderID)
+
+		//triggered before a restart, or while a cancel was failing, with the take profit still open.
+		if m.awaitingCancel(group) {
+			m.stopTakeProfit(group)
+		}
+	}
+}
+
+//readBack handle an order's state from account/getorder as if it had arrived as a delta.
+func (m *OrderGroupManager) readBack(orderID string) {
+	groupID, ok := m.byOrder[orderID]
+	if orderID == "" || !ok {
+		return
+	}
+
+	order, getErr := m.client.AccountGetOrder(orderID)
+	if getErr != nil {
+		m.emit(OrderGroupError, m.groups[groupID], getErr)
+		return
+	}
+
+	deltaType := socketPayloads.OrderDeltaOpen
+	switch {
+	case !order.IsOpen && order.QuantityRemaining <= quantityEpsilon:
+		deltaType = socketPayloads.OrderDeltaFill
+	case !order.IsOpen:
+		deltaType = socketPayloads.OrderDeltaCancel
+	case order.QuantityRemaining < order.Quantity:
+		deltaType = socketPayloads.OrderDeltaPartial
+	}
+
+	m.handleDelta(deltaType, orderID, order.Quantity, order.QuantityRemaining)
+}
+
+func (m *OrderGroupManager) handleDelta(deltaType int, orderID string, quantity, remaining decimal) {
+	groupID, ok := m.byOrder[orderID]
+	if !ok {
+		return
+	}
+
+	group, ok := m.groups[groupID]
+	if !ok || group.State != OrderGroupActive {
+		return
+	}
+
+	var leg *OrderGroupLeg
+	switch orderID {
+	case group.TakeProfit.OrderID:
+		leg = &group.TakeProfit
+	case group.StopLoss.OrderID:
+		leg = &group.StopLoss
+	default:
+		return
+	}
+
+	filled := quantity - remaining
+	if filled > leg.CurrentFilled+quantityEpsilon/2 {
+		leg.CurrentFilled = filled
+		group.Updated = time.Now()
+		m.emit(OrderGroupLegFilled, group, nil)
+	}
+
+	stopping := leg == &group.TakeProfit && !group.StopLoss.Triggered.IsZero()
+
+	switch {
+	case group.Remaining() <= quantityEpsilon:
+		m.finish(group, OrderGroupCompleted, fmt.Sprintf("order %s filled", orderID))
+	case deltaType == socketPayloads.OrderDeltaFill && !stopping:
+		m.finish(group, OrderGroupCompleted, fmt.Sprintf("order %s filled", orderID))
+	case stopping && group.StopLoss.OrderID != "":
+		//the take profit's cancel, seen again after the stop loss was placed.
+	case stopping && (deltaType == socketPayloads.OrderDeltaCancel || deltaType == socketPayloads.OrderDeltaFill):
+		if placeErr := m.placeLeg(group, &group.StopLoss, group.Remaining()); placeErr != nil {
+			m.finish(group, OrderGroupFailed, fmt.Sprintf("stop triggered, but the stop loss could not be placed: %s", placeErr.Error()))
+			return
+		}
+		group.Updated = time.Now()
+	case deltaType == socketPayloads.OrderDeltaCancel:
+		//the live leg was cancelled outside the manager, so the position is no longer protected.
+		m.finish(group, OrderGroupCancelled, fmt.Sprintf("order %s cancelled outside the order group", orderID))
+		return
+	}
+
+	m.commit(group)
+}
+
+func (m *OrderGroupManager) placeLeg(group *OrderGroup, leg *OrderGroupLeg, quantity decimal) error {
+	//the stop loss' condition is watched here rather than by the exchange, so both legs are plain limit orders.
+	placed, placeErr := m.client.PlaceOrder(OrderRequest{
+		Market:      group.Market,
+		Side:        group.Side,
+		Type:        OrderTypeLimit,
+		Quantity:    quantity,
+		Rate:        leg.Rate,
+		TimeInForce: OrderTimeGTC,
+	})
+
+	if placeErr != nil {
+		return placeErr
+	}
+
+	if placed.OrderID == "" {
+		return fmt.Errorf("order group %s: exchange did not return an order id", group.ID)
+	}
+
+	leg.OrderID = placed.OrderID
+	leg.Quantity = placed.Normalized.Quantity
+	m.byOrder[leg.OrderID] = group.ID
+
+	return nil
+}
+
+func (m *OrderGroupManager) cancelOrder(orderID string) error {
+	if orderID == "" {
+		return nil
+	}
+
+	_, cancelErr := m.client.MarketCancel(orderID)
+	return cancelErr
+}
+
+func (m *OrderGroupManager) finish(group *OrderGroup, state OrderGroupState, reason string) {
+	group.State = state
+	group.Reason = reason
+	group.Updated = time.Now()
+
+	m.commit(group)
+
+	eventType := OrderGroupDone
+	if state == OrderGroupFailed {
+		eventType = OrderGroupError
+	}
+
+	m.emit(eventType, group, nil)
+}
+
+func (m *OrderGroupManager) index(group *OrderGroup) {
+	if group.State != OrderGroupActive {
+		return
+	}
+
+	for _, orderID := range []string{group.TakeProfit.OrderID, group.StopLoss.OrderID} {
+		if orderID != "" {
+			m.byOrder[orderID] = group.ID
+		}
+	}
+}
+
+//commit publish a copy of group to Groups and save every group to the store.
+func (m *OrderGroupManager) commit(group *OrderGroup) {
+	m.mutex.Lock()
+	m.published[group.ID] = *group
+
+	groups := make([]OrderGroup, 0, len(m.published))
+	for _, published := range m.published {
+		groups = append(groups, published)
+	}
+	m.mutex.Unlock()
+
+	if m.store == nil {
+		return
+	}
+
+	if saveErr := m.store.SaveOrderGroups(groups); saveErr != nil {
+		m.emit(OrderGroupError, &OrderGroup{}, saveErr)
+	}
+}
+
+func (m *OrderGroupManager) emit(eventType OrderGroupEventType, group *OrderGroup, err error) {
+	select {
+	case m.events <- OrderGroupEvent{Type: eventType, Group: *group, Err: err}:
+	default:
+	}
+}
+
+//newLocalID random identifier for objects that only exist client side.
+func newLocalID() string {
+	raw := make([]byte, 16)
+	rand.Read(raw)
+	return hex.EncodeToString(raw)
+}
+
+//loadJSONFile read path into target.  a missing file leaves target untouched.
+func loadJSONFile(path string, target interface{}) error {
+	raw, readErr := ioutil.ReadFile(path)
+
+	if os.IsNotExist(readErr) {
+		return nil
+	}
+
+	if readErr != nil {
+		return readErr
+	}
+
+	if parseErr := json.Unmarshal(raw, target); parseErr != nil {
+		return fmt.Errorf("unable to parse %s: %+v", path, parseErr)
+	}
+
+	return nil
+}
+
+//saveJSONFile write value to path via a temporary file, so a crash never leaves a half written file behind.
+func saveJSONFile(path string, value interface{}) error {
+	raw, marshalErr := json.MarshalIndent(value, "", "  ")
+	if marshalErr != nil {
+		return marshalErr
+	}
+
+	tmp := path + ".tmp"
+	if writeErr := ioutil.WriteFile(tmp, raw, 0600); writeErr != nil {
+		return writeErr
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,128 @@
+package bittrex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/technicalviking/bittrex2/cassette"
+)
+
+//newTestOrderGroupManager a manager answered from interactions, without a socket or its goroutine.
+func newTestOrderGroupManager(interactions []cassette.Interaction) *OrderGroupManager {
+	c, _ := New("key", "secret")
+	c.SetHTTPTransport(cassette.NewPlayer(&cassette.Cassette{Interactions: interactions}))
+
+	return &OrderGroupManager{
+		client:      c,
+		groups:      make(map[string]*OrderGroup),
+		byOrder:     make(map[string]string),
+		cancelled:   make(map[string]time.Time),
+		published:   make(map[string]OrderGroup),
+		prices:      make(map[string]decimal),
+		priceSignal: make(chan struct{}, 1),
+		events:      make(chan OrderGroupEvent, 100),
+		done:        make(chan struct{}),
+	}
+}
+
+func (m *OrderGroupManager) addTestGroup(group OrderGroup) *OrderGroup {
+	m.groups[group.ID] = &group
+	m.index(&group)
+	return &group
+}
+
+func testBracketGroup() OrderGroup {
+	return OrderGroup{
+		ID:         "group",
+		Market:     "BTC-LTC",
+		Side:       OrderSideSell,
+		Quantity:   1,
+		State:      OrderGroupActive,
+		TakeProfit: OrderGroupLeg{OrderID: "tp", Rate: 0.02, Quantity: 1},
+		StopLoss:   OrderGroupLeg{Rate: 0.009, Condition: OrderConditionLT, ConditionTarget: 0.01},
+	}
+}
+
+func cancelInteraction(body string) cassette.Interaction {
+	return cassette.Interaction{Endpoint: "v1.1/market/cancel", Params: map[string]string{"uuid": "tp"}, StatusCode: 200, Body: body}
+}
+
+func getOrderInteraction(open bool) cassette.Interaction {
+	isOpen := "false"
+	if open {
+		isOpen = "true"
+	}
+
+	return cassette.Interaction{
+		Endpoint:   "v1.1/account/getorder",
+		Params:     map[string]string{"uuid": "tp"},
+		StatusCode: 200,
+		Body:       `{"success":true,"message":"","result":{"OrderUuid":"tp","Exchange":"BTC-LTC","Type":"LIMIT_SELL","Quantity":1,"QuantityRemaining":1,"Limit":0.02,"IsOpen":` + isOpen + `}}`,
+	}
+}
+
+var stopLossInteraction = cassette.Interaction{
+	Endpoint: "v2.0/key/market/tradesell",
+	Params: map[string]string{
+		"marketName":    "BTC-LTC",
+		"orderType":     "LIMIT",
+		"quantity":      "1.00000000",
+		"rate":          "0.00900000",
+		"timeInEffect":  "GOOD_TIL_CANCELLED",
+		"conditionType": "NONE",
+		"target":        "0",
+	},
+	StatusCode: 200,
+	Body:       `{"success":true,"message":"","result":{"OrderId":"sl","MarketName":"BTC-LTC"}}`,
+}
+
+func TestOrderGroupRetriesFailedTakeProfitCancel(t *testing.T) {
+	m := newTestOrderGroupManager([]cassette.Interaction{
+		cancelInteraction(`{"success":false,"message":"INTERNAL_ERROR","result":null}`),
+		cancelInteraction(`{"success":true,"message":"","result":null}`),
+		getOrderInteraction(true),
+		getOrderInteraction(false),
+		stopLossInteraction,
+	})
+	group := m.addTestGroup(testBracketGroup())
+	m.prices["BTC-LTC"] = 0.0099
+
+	m.checkTriggers()
+
+	if group.StopLoss.Triggered.IsZero() || group.StopLoss.OrderID != "" || group.State != OrderGroupActive {
+		t.Fatalf("expected the stop to wait on the take profit, got %+v", group)
+	}
+
+	//too soon to try the cancel again.
+	m.checkTriggers()
+
+	if group.StopLoss.OrderID != "" {
+		t.Fatalf("cancel retried early, got %+v", group)
+	}
+
+	m.cancelled[group.ID] = time.Now().Add(-orderGroupCancelRetry)
+	m.checkTriggers()
+
+	if group.StopLoss.OrderID != "sl" || group.StopLoss.Quantity != 1 || group.State != OrderGroupActive {
+		t.Fatalf("expected the stop loss to be placed once the take profit was cancelled, got %+v", group)
+	}
+}
+
+func TestOrderGroupReconcileCancelsTriggeredTakeProfit(t *testing.T) {
+	m := newTestOrderGroupManager([]cassette.Interaction{
+		getOrderInteraction(true),
+		getOrderInteraction(false),
+		cancelInteraction(`{"success":true,"message":"","result":null}`),
+		stopLossInteraction,
+	})
+
+	saved := testBracketGroup()
+	saved.StopLoss.Triggered = time.Now().Add(-time.Hour)
+	group := m.addTestGroup(saved)
+
+	m.reconcile()
+
+	if group.StopLoss.OrderID != "sl" || group.State != OrderGroupActive {
+		t.Fatalf("expected reconcile to cancel the take profit and place the stop loss, got %+v", group)
+	}
+}
@@ -0,0 +1,235 @@
+package bittrex
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+//OrderSide direction of an order.
+type OrderSide string
+
+//Order Sides
+const (
+	OrderSideBuy  OrderSide = "BUY"
+	OrderSideSell OrderSide = "SELL"
+)
+
+//RoundingMode how a value is brought to the exchange precision.
+type RoundingMode int
+
+//Rounding Modes
+const (
+	RoundNearest RoundingMode = iota
+	RoundDown
+	RoundUp
+)
+
+/*
+float64 products like 0.29999999999999998 * 1e8 land a hair below the intended integer.
+values within this many units of the last decimal place are treated as exact before flooring or ceiling.
+*/
+const roundingTolerance float64 = 1e-6
+
+//bittrex accepts (and reports) 8 decimal places for both rates and quantities.
+const exchangeDecimals int = 8
+
+//DustOrderError returned when an order is too small to be accepted by the exchange.
+type DustOrderError struct {
+	Market       string
+	Quantity     decimal
+	Rate         decimal
+	MinTradeSize decimal
+	MinNotional  decimal
+}
+
+//Error implement error interface
+func (e DustOrderError) Error() string {
+	if e.MinNotional > 0 && e.Quantity*e.Rate < e.MinNotional {
+		return fmt.Sprintf(
+			"dust order on %s: value %.8f is below the minimum of %.8f",
+			e.Market, e.Quantity*e.Rate, e.MinNotional,
+		)
+	}
+
+	return fmt.Sprintf(
+		"dust order on %s: quantity %.8f is below the minimum trade size of %.8f",
+		e.Market, e.Quantity, e.MinTradeSize,
+	)
+}
+
+//NormalizedOrder the values actually sent, alongside what was requested.
+type NormalizedOrder struct {
+	Market            string
+	Side              OrderSide
+	Quantity          decimal
+	Rate              decimal
+	RequestedQuantity decimal
+	RequestedRate     decimal
+}
+
+//Adjusted true if rounding changed either value.
+func (n NormalizedOrder) Adjusted() bool {
+	return n.Quantity != n.RequestedQuantity || n.Rate != n.RequestedRate
+}
+
+/*
+OrderNormalizer rounds order values to exchange precision and rejects dust before submission.
+The defaults never work against the caller: buy rates round down, sell rates round up,
+and quantities round down so an order never exceeds the available balance.
+*/
+type OrderNormalizer struct {
+	RateDecimals     int
+	QuantityDecimals int
+
+	BuyRateRounding  RoundingMode
+	SellRateRounding RoundingMode
+	QuantityRounding RoundingMode
+
+	//MinNotional minimum order value (quantity * rate) keyed by quote currency, eg BTC in BTC-LTC.
+	MinNotional map[string]decimal
+
+	//OnAdjust called whenever rounding changed the requested values.
+	OnAdjust func(NormalizedOrder)
+}
+
+//NewOrderNormalizer construct a normalizer with the exchange precision and conservative rounding.
+func NewOrderNormalizer() *OrderNormalizer {
+	return &OrderNormalizer{
+		RateDecimals:     exchangeDecimals,
+		QuantityDecimals: exchangeDecimals,
+		BuyRateRounding:  RoundDown,
+		SellRateRounding: RoundUp,
+		QuantityRounding: RoundDown,
+		MinNotional: map[string]decimal{
+			//bittrex rejects BTC orders worth less than 50k satoshi with DUST_TRADE_DISALLOWED_MIN_VALUE_50K_SAT
+			"BTC": 0.0005,
+		},
+	}
+}
+
+/*
+Normalize round quantity and rate for the given side, then reject the order if it is dust.
+minTradeSize of zero skips that check.
+*/
+func (n *OrderNormalizer) Normalize(market string, side OrderSide, quantity, rate, minTradeSize decimal) (NormalizedOrder, error) {
+	rateRounding := n.BuyRateRounding
+	if side == OrderSideSell {
+		rateRounding = n.SellRateRounding
+	}
+
+	result := NormalizedOrder{
+		Market:            market,
+		Side:              side,
+		Quantity:          roundDecimal(quantity, n.QuantityDecimals, n.QuantityRounding),
+		Rate:              roundDecimal(rate, n.RateDecimals, rateRounding),
+		RequestedQuantity: quantity,
+		RequestedRate:     rate,
+	}
+
+	minNotional := n.minNotional(market)
+
+	if result.Quantity <= 0 || result.Quantity < minTradeSize || (minNotional > 0 && result.Rate > 0 && result.Quantity*result.Rate < minNotional) {
+		return result, DustOrderError{
+			Market:       market,
+			Quantity:     result.Quantity,
+			Rate:         result.Rate,
+			MinTradeSize: minTradeSize,
+			MinNotional:  minNotional,
+		}
+	}
+
+	if result.Adjusted() && n.OnAdjust != nil {
+		n.OnAdjust(result)
+	}
+
+	return result, nil
+}
+
+//RoundRate round a rate, such as a condition target, to the rate precision.
+func (n *OrderNormalizer) RoundRate(rate decimal, mode RoundingMode) decimal {
+	return roundDecimal(rate, n.RateDecimals, mode)
+}
+
+func (n *OrderNormalizer) minNotional(market string) decimal {
+	if n.MinNotional == nil {
+		return 0
+	}
+
+	//bittrex market names put the quote currency first.
+	quote := strings.ToUpper(strings.SplitN(market, "-", 2)[0])
+
+	return n.MinNotional[quote]
+}
+
+func roundDecimal(value decimal, decimals int, mode RoundingMode) decimal {
+	scale := math.Pow(10, float64(decimals))
+	scaled := value * scale
+
+	switch mode {
+	case RoundDown:
+		scaled = math.Floor(scaled + roundingTolerance)
+	case RoundUp:
+		scaled = math.Ceil(scaled - roundingTolerance)
+	default:
+		scaled = math.Round(scaled)
+	}
+
+	return scaled / scale
+}
+
+//SetOrderNormalizer round and dust check every order before it is sent.  Set to nil to disable.
+func (c *Client) SetOrderNormalizer(n *OrderNormalizer) {
+	c.orderNormalizer = n
+}
+
+/*
+NormalizeOrder preview the values an order would be sent with.
+Without a normalizer attached the values are returned unchanged.
+*/
+func (c *Client) NormalizeOrder(market string, side OrderSide, quantity, rate decimal) (NormalizedOrder, error) {
+	if c.orderNormalizer == nil {
+		return NormalizedOrder{
+			Market:            market,
+			Side:              side,
+			Quantity:          quantity,
+			Rate:              rate,
+			RequestedQuantity: quantity,
+			RequestedRate:     rate,
+		}, nil
+	}
+
+	var minTradeSize decimal
+	if c.marketRegistry != nil {
+		if m, ok := c.marketRegistry.Market(market); ok {
+			minTradeSize = m.MinTradeSize
+		}
+	}
+
+	return c.orderNormalizer.Normalize(market, side, quantity, rate, minTradeSize)
+}
+
+//prepareOrder run every local check on an order, returning the values to send and its risk reservation, if any.
+func (c *Client) prepareOrder(market string, side OrderSide, quantity, rate decimal) (NormalizedOrder, *riskReservation, error) {
+	if guard := c.attachedSessionGuard(); guard != nil {
+		if blockErr := guard.blocking(); blockErr != nil {
+			return NormalizedOrder{Market: market, Side: side, Quantity: quantity, Rate: rate, RequestedQuantity: quantity, RequestedRate: rate}, nil, blockErr
+		}
+	}
+
+	normalized, normalizeErr := c.NormalizeOrder(market, side, quantity, rate)
+	if normalizeErr != nil {
+		return normalized, nil, normalizeErr
+	}
+
+	if validateErr := c.validateOrder(market, normalized.Quantity); validateErr != nil {
+		return normalized, nil, validateErr
+	}
+
+	reservation, riskErr := c.checkRisk(market, side, normalized.Quantity, normalized.Rate)
+	if riskErr != nil {
+		return normalized, nil, riskErr
+	}
+
+	return normalized, reservation, nil
+}
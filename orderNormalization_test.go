@@ -0,0 +1,130 @@
+package bittrex
+
+import (
+	"testing"
+)
+
+func TestRoundDecimal(t *testing.T) {
+	for _, test := range []struct {
+		value    decimal
+		decimals int
+		mode     RoundingMode
+		expected decimal
+	}{
+		{0.123456789, 8, RoundDown, 0.12345678},
+		{0.123456789, 8, RoundUp, 0.12345679},
+		{0.123456789, 8, RoundNearest, 0.12345679},
+		{0.123456784, 8, RoundNearest, 0.12345678},
+		{0.12345678, 8, RoundDown, 0.12345678},
+		{0.12345678, 8, RoundUp, 0.12345678},
+		//0.1 + 0.2 is 0.30000000000000004 and 0.3 scales to a hair below 30000000; neither may step a place.
+		{0.1 + 0.2, 8, RoundUp, 0.3},
+		{0.3, 8, RoundDown, 0.3},
+		{1.000000001, 8, RoundUp, 1.00000001},
+		{1.000000009, 8, RoundDown, 1},
+		{12.345, 2, RoundDown, 12.34},
+		{12.341, 2, RoundUp, 12.35},
+		{0.000000004, 8, RoundDown, 0},
+		{0.000000004, 8, RoundUp, 0.00000001},
+	} {
+		if got := roundDecimal(test.value, test.decimals, test.mode); got != test.expected {
+			t.Errorf("roundDecimal(%v, %d, %d) = %v, expected %v", test.value, test.decimals, test.mode, got, test.expected)
+		}
+	}
+}
+
+func TestOrderNormalizerNormalize(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		market       string
+		side         OrderSide
+		quantity     decimal
+		rate         decimal
+		minTradeSize decimal
+		quantityOut  decimal
+		rateOut      decimal
+		dust         bool
+	}{
+		{"buy rate rounds down", "BTC-LTC", OrderSideBuy, 1.123456789, 0.016123456789, 0, 1.12345678, 0.01612345, false},
+		{"sell rate rounds up", "BTC-LTC", OrderSideSell, 1.123456789, 0.016123456789, 0, 1.12345678, 0.01612346, false},
+		{"exact values unchanged", "BTC-LTC", OrderSideBuy, 2, 0.0161, 0.01, 2, 0.0161, false},
+		{"quantity rounds to zero", "BTC-LTC", OrderSideBuy, 0.000000009, 0.0161, 0, 0, 0.0161, true},
+		{"below min trade size", "BTC-LTC", OrderSideBuy, 0.005, 1, 0.01, 0.005, 1, true},
+		{"below min notional", "BTC-LTC", OrderSideBuy, 0.02, 0.0161, 0.01, 0.02, 0.0161, true},
+		{"at min notional", "BTC-LTC", OrderSideSell, 0.05, 0.01, 0.01, 0.05, 0.01, false},
+		{"lower case market", "btc-ltc", OrderSideBuy, 0.02, 0.0161, 0, 0.02, 0.0161, true},
+		{"no min notional for the quote", "ETH-LTC", OrderSideBuy, 0.02, 0.0161, 0.01, 0.02, 0.0161, false},
+		{"no rate skips min notional", "BTC-LTC", OrderSideSell, 0.02, 0, 0, 0.02, 0, false},
+	} {
+		var adjusted []NormalizedOrder
+
+		n := NewOrderNormalizer()
+		n.OnAdjust = func(order NormalizedOrder) {
+			adjusted = append(adjusted, order)
+		}
+
+		order, err := n.Normalize(test.market, test.side, test.quantity, test.rate, test.minTradeSize)
+
+		if _, isDust := err.(DustOrderError); isDust != test.dust {
+			t.Errorf("%s: expected dust %v, got %v", test.name, test.dust, err)
+		}
+
+		if order.Quantity != test.quantityOut || order.Rate != test.rateOut {
+			t.Errorf("%s: expected %v @ %v, got %v @ %v", test.name, test.quantityOut, test.rateOut, order.Quantity, order.Rate)
+		}
+
+		if order.RequestedQuantity != test.quantity || order.RequestedRate != test.rate {
+			t.Errorf("%s: requested values not kept, got %+v", test.name, order)
+		}
+
+		if expected := order.Adjusted() && !test.dust; (len(adjusted) == 1) != expected {
+			t.Errorf("%s: expected OnAdjust called %v, got %d calls", test.name, expected, len(adjusted))
+		}
+	}
+}
+
+func TestDustOrderErrorNamesTheFailedCheck(t *testing.T) {
+	for _, test := range []struct {
+		err      DustOrderError
+		expected string
+	}{
+		{
+			DustOrderError{Market: "BTC-LTC", Quantity: 0.02, Rate: 0.0161, MinTradeSize: 0.01, MinNotional: 0.0005},
+			"dust order on BTC-LTC: value 0.00032200 is below the minimum of 0.00050000",
+		},
+		{
+			DustOrderError{Market: "BTC-LTC", Quantity: 0.005, Rate: 1, MinTradeSize: 0.01, MinNotional: 0.0005},
+			"dust order on BTC-LTC: quantity 0.00500000 is below the minimum trade size of 0.01000000",
+		},
+	} {
+		if got := test.err.Error(); got != test.expected {
+			t.Errorf("got %q, expected %q", got, test.expected)
+		}
+	}
+}
+
+func TestClientNormalizeOrder(t *testing.T) {
+	c, _ := New("key", "secret")
+
+	order, err := c.NormalizeOrder("BTC-LTC", OrderSideBuy, 0.000000009, 0.016123456789)
+	if err != nil || order.Quantity != 0.000000009 || order.Rate != 0.016123456789 || order.Adjusted() {
+		t.Errorf("expected values unchanged without a normalizer, got %+v, %v", order, err)
+	}
+
+	c.SetOrderNormalizer(NewOrderNormalizer())
+	c.UseMarketRegistry(&MarketRegistry{
+		markets: map[string]MarketDescription{"BTC-LTC": {MarketName: "BTC-LTC", MinTradeSize: 0.1}},
+	})
+
+	order, err = c.NormalizeOrder("BTC-LTC", OrderSideBuy, 1.123456789, 0.016123456789)
+	if err != nil || order.Quantity != 1.12345678 || order.Rate != 0.01612345 {
+		t.Errorf("expected the order rounded, got %+v, %v", order, err)
+	}
+
+	//over the minimum notional, under the registry's minimum trade size.
+	if _, err = c.NormalizeOrder("BTC-LTC", OrderSideBuy, 0.05, 0.02); err == nil {
+		t.Error("expected the registry's minimum trade size to be applied")
+	} else if dust, ok := err.(DustOrderError); !ok || dust.MinTradeSize != 0.1 {
+		t.Errorf("expected a dust error with the registry's minimum trade size, got %v", err)
+	}
+}
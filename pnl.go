@@ -0,0 +1,546 @@
+package bittrex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//CostMethod how sales are matched against the lots they dispose of.
+type CostMethod int
+
+//Cost Methods
+const (
+	CostFIFO    CostMethod = iota //oldest lots first
+	CostLIFO                      //newest lots first
+	CostAverage                   //every lot in a market pooled at its average cost
+)
+
+//BookedFill all or part of an order, as booked by a PnLEngine.  Commission is in the market's quote currency.
+type BookedFill struct {
+	OrderUUID  string
+	Market     string
+	Side       OrderSide
+	Quantity   decimal
+	Price      decimal
+	Commission decimal
+	Time       time.Time
+}
+
+//Lot a quantity bought at one cost.  CostPerUnit includes the buy's commission.
+type Lot struct {
+	Market      string
+	Currency    string
+	OrderUUID   string
+	Quantity    decimal
+	Remaining   decimal
+	CostPerUnit decimal
+	Acquired    time.Time
+}
+
+/*
+Disposal part of a sale matched against one lot.  Amounts are in the market's quote currency, and Proceeds are net of
+the sale's commission.  Unmatched marks quantity sold with no lot to match it (bought before the history starts, or
+deposited).  Its cost basis is unknown, so CostBasis and Gain are left at zero and it counts towards the market's
+Unmatched rather than Realized.  With CostAverage, Acquired and BuyOrderUUID are those of the first buy in the pool.
+*/
+type Disposal struct {
+	Market        string
+	Currency      string
+	Quote         string
+	Quantity      decimal
+	Proceeds      decimal
+	CostBasis     decimal
+	Gain          decimal
+	Acquired      time.Time
+	Disposed      time.Time
+	BuyOrderUUID  string
+	SellOrderUUID string
+	Unmatched     bool
+}
+
+//MarketPnL profit and loss for one market, in its quote currency.
+type MarketPnL struct {
+	Market   string
+	Currency string
+	Quote    string
+
+	Position    decimal
+	CostBasis   decimal
+	AverageCost decimal
+	Commission  decimal
+
+	Realized   decimal
+	Unrealized decimal
+	MarkPrice  decimal
+	//Unmarked no mark price was given for an open position, so Unrealized is zero.
+	Unmarked bool
+
+	//Unmatched quantity sold with no lot to match it, and the proceeds of that quantity.  Neither is in Realized.
+	Unmatched         decimal
+	UnmatchedProceeds decimal
+}
+
+//PnLReport profit and loss per market, with totals per quote currency.
+type PnLReport struct {
+	Method     CostMethod
+	Markets    []MarketPnL
+	Realized   map[string]decimal
+	Unrealized map[string]decimal
+	Time       time.Time
+}
+
+//PnLConfig arguments for NewPnLEngine.
+type PnLConfig struct {
+	Method CostMethod
+
+	//OnDisposal optional callback for every disposal booked.  RiskManager.UsePnLEngine needs none.
+	OnDisposal func(Disposal)
+}
+
+type pnlPosition struct {
+	market            Market
+	lots              []*Lot
+	realized          decimal
+	commission        decimal
+	unmatched         decimal
+	unmatchedProceeds decimal
+}
+
+type bookedOrder struct {
+	quantity   decimal
+	notional   decimal
+	commission decimal
+}
+
+/*
+PnLEngine builds cost basis lots from order history and live order deltas, and reports realized and unrealized
+profit and loss.  Trades must be booked in time order; LoadHistory sorts what it loads.  Each order is booked once,
+however many times it is seen, so history and deltas may overlap.
+*/
+type PnLEngine struct {
+	client *Client
+	config PnLConfig
+
+	mutex     sync.RWMutex
+	positions map[string]*pnlPosition
+	disposals []Disposal
+
+	//bookMutex held from reading booked to updating it, so an order's deltas are booked one at a time.
+	bookMutex sync.Mutex
+	booked    map[string]bookedOrder //order id -> what has been booked so far
+
+	errChan chan error
+
+	listenerMutex  sync.Mutex
+	removeListener func()
+
+	disposalMutex     sync.RWMutex
+	disposalListeners map[int]func(Disposal)
+	nextDisposalID    int
+}
+
+//NewPnLEngine an empty engine.  Load history with LoadHistory and follow live fills with Follow.
+func NewPnLEngine(c *Client, config PnLConfig) *PnLEngine {
+	return &PnLEngine{
+		client:            c,
+		config:            config,
+		positions:         make(map[string]*pnlPosition),
+		booked:            make(map[string]bookedOrder),
+		errChan:           make(chan error, 5),
+		removeListener:    func() {},
+		disposalListeners: make(map[int]func(Disposal)),
+	}
+}
+
+//LoadHistory book every filled order in the market's order history.  empty market loads every market.
+func (e *PnLEngine) LoadHistory(market string) error {
+	history, historyErr := e.client.AccountGetOrderHistory(market)
+	if historyErr != nil {
+		return historyErr
+	}
+
+	return e.bookHistory(history)
+}
+
+//bookHistory book orders from the order history, oldest first.  history is sorted in place.
+func (e *PnLEngine) bookHistory(history []AccountOrderHistoryDescription) error {
+	sort.SliceStable(history, func(i, j int) bool {
+		return time.Time(history[i].TimeStamp).Before(time.Time(history[j].TimeStamp))
+	})
+
+	for _, order := range history {
+		side := OrderSideSell
+		if strings.Contains(strings.ToUpper(order.OrderType), "BUY") {
+			side = OrderSideBuy
+		}
+
+		if bookErr := e.bookOrder(order.OrderUUID, order.Exchange, side, order.Quantity-order.QuantityRemaining, order.PricePerUnit, order.Price, order.Commission, time.Time(order.TimeStamp)); bookErr != nil {
+			return bookErr
+		}
+	}
+
+	return nil
+}
+
+//Follow book fills from the order deltas as they happen.
+func (e *PnLEngine) Follow() {
+	e.listenerMutex.Lock()
+	defer e.listenerMutex.Unlock()
+
+	e.removeListener()
+	e.removeListener = e.client.addOrderListener(e.orderDelta)
+}
+
+//Errors order deltas that could not be booked.  Buffered; errors are dropped if it fills.
+func (e *PnLEngine) Errors() chan error {
+	return e.errChan
+}
+
+//Close stop following the order deltas.
+func (e *PnLEngine) Close() {
+	e.listenerMutex.Lock()
+	defer e.listenerMutex.Unlock()
+
+	e.removeListener()
+	e.removeListener = func() {}
+}
+
+//RecordFill book a fill from elsewhere, eg another exchange's fill being mirrored here.
+func (e *PnLEngine) RecordFill(trade BookedFill) error {
+	disposals, bookErr := e.book(trade)
+	if bookErr != nil {
+		return bookErr
+	}
+
+	e.notify(disposals)
+
+	return nil
+}
+
+//Lots open lots in market, oldest first.
+func (e *PnLEngine) Lots(market string) []Lot {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	position, ok := e.positions[strings.ToUpper(market)]
+	if !ok {
+		return nil
+	}
+
+	lots := make([]Lot, 0, len(position.lots))
+	for _, lot := range position.lots {
+		lots = append(lots, *lot)
+	}
+
+	return lots
+}
+
+//Disposals every disposal booked, in the order they were booked.
+func (e *PnLEngine) Disposals() []Disposal {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	return append([]Disposal(nil), e.disposals...)
+}
+
+//Report profit and loss, marking open positions at marks (market name -> price).
+func (e *PnLEngine) Report(marks map[string]decimal) PnLReport {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	report := PnLReport{
+		Method:     e.config.Method,
+		Realized:   make(map[string]decimal),
+		Unrealized: make(map[string]decimal),
+		Time:       time.Now(),
+	}
+
+	for name, position := range e.positions {
+		result := MarketPnL{
+			Market:     name,
+			Currency:   position.market.Base(),
+			Quote:      position.market.Quote(),
+			Realized:   position.realized,
+			Commission: position.commission,
+
+			Unmatched:         position.unmatched,
+			UnmatchedProceeds: position.unmatchedProceeds,
+		}
+
+		for _, lot := range position.lots {
+			result.Position += lot.Remaining
+			result.CostBasis += lot.Remaining * lot.CostPerUnit
+		}
+
+		if result.Position > quantityEpsilon {
+			result.AverageCost = result.CostBasis / result.Position
+
+			if mark, ok := marks[name]; ok && mark > 0 {
+				result.MarkPrice = mark
+				result.Unrealized = result.Position*mark - result.CostBasis
+			} else {
+				result.Unmarked = true
+			}
+		}
+
+		report.Realized[result.Quote] += result.Realized
+		report.Unrealized[result.Quote] += result.Unrealized
+		report.Markets = append(report.Markets, result)
+	}
+
+	sort.Slice(report.Markets, func(i, j int) bool {
+		return report.Markets[i].Market < report.Markets[j].Market
+	})
+
+	return report
+}
+
+func (e *PnLEngine) orderDelta(delta socketPayloads.OrderResponse) {
+	order := delta.Order
+
+	side := OrderSideSell
+	if strings.Contains(strings.ToUpper(order.OrderType), "BUY") {
+		side = OrderSideBuy
+	}
+
+	bookErr := e.bookOrder(order.OrderUUID, order.Exchange, side, order.Quantity-order.QuantityRemaining, order.PricePerUnit, order.Price, order.CommissionPaid, order.Updated.Get())
+	if bookErr != nil {
+		select {
+		case e.errChan <- bookErr:
+		default:
+		}
+	}
+}
+
+/*
+bookOrder book whatever part of an order's fill and commission hasn't been booked yet.  pricePerUnit is the average
+over the whole order, so the new part is priced from the change in the order's notional.
+*/
+func (e *PnLEngine) bookOrder(orderID, market string, side OrderSide, filled, pricePerUnit, price, commission decimal, at time.Time) error {
+	if pricePerUnit <= 0 && filled > 0 {
+		pricePerUnit = price / filled
+	}
+
+	e.bookMutex.Lock()
+	defer e.bookMutex.Unlock()
+
+	previous := e.booked[orderID]
+	quantity := filled - previous.quantity
+	fee := commission - previous.commission
+
+	if quantity <= quantityEpsilon/2 && fee <= 0 {
+		return nil
+	}
+
+	notional := previous.notional
+	incrementPrice := pricePerUnit
+
+	if quantity > quantityEpsilon/2 {
+		notional = filled * pricePerUnit
+		if incrementNotional := notional - previous.notional; incrementNotional > 0 {
+			incrementPrice = incrementNotional / quantity
+		}
+	} else {
+		quantity = 0
+	}
+
+	if recordErr := e.RecordFill(BookedFill{
+		OrderUUID:  orderID,
+		Market:     market,
+		Side:       side,
+		Quantity:   quantity,
+		Price:      incrementPrice,
+		Commission: fee,
+		Time:       at,
+	}); recordErr != nil {
+		return recordErr
+	}
+
+	e.booked[orderID] = bookedOrder{
+		quantity:   previous.quantity + quantity,
+		notional:   notional,
+		commission: commission,
+	}
+
+	return nil
+}
+
+func (e *PnLEngine) book(trade BookedFill) ([]Disposal, error) {
+	parsed, parseErr := ParseMarket(trade.Market)
+	if parseErr != nil {
+		return nil, fmt.Errorf("pnl - trade %s: %s", trade.OrderUUID, parseErr.Error())
+	}
+
+	name := parsed.String()
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	position, ok := e.positions[name]
+	if !ok {
+		position = &pnlPosition{market: parsed}
+		e.positions[name] = position
+	}
+
+	position.commission += trade.Commission
+
+	if trade.Side == OrderSideBuy {
+		e.buyLocked(position, trade)
+		return nil, nil
+	}
+
+	disposals := e.sellLocked(position, trade)
+	e.disposals = append(e.disposals, disposals...)
+
+	return disposals, nil
+}
+
+func (e *PnLEngine) buyLocked(position *pnlPosition, trade BookedFill) {
+	if trade.Quantity <= 0 {
+		//a commission adjustment with no new quantity; spread it over what is held.
+		if held := positionSize(position); held > quantityEpsilon {
+			for _, lot := range position.lots {
+				lot.CostPerUnit += trade.Commission / held
+			}
+		}
+		return
+	}
+
+	costPerUnit := trade.Price + trade.Commission/trade.Quantity
+
+	if e.config.Method == CostAverage && len(position.lots) > 0 {
+		pooled := position.lots[0]
+		total := pooled.Remaining*pooled.CostPerUnit + trade.Quantity*costPerUnit
+		pooled.Remaining += trade.Quantity
+		pooled.Quantity += trade.Quantity
+		pooled.CostPerUnit = total / pooled.Remaining
+		return
+	}
+
+	position.lots = append(position.lots, &Lot{
+		Market:      position.market.String(),
+		Currency:    position.market.Base(),
+		OrderUUID:   trade.OrderUUID,
+		Quantity:    trade.Quantity,
+		Remaining:   trade.Quantity,
+		CostPerUnit: costPerUnit,
+		Acquired:    trade.Time,
+	})
+}
+
+func (e *PnLEngine) sellLocked(position *pnlPosition, trade BookedFill) []Disposal {
+	if trade.Quantity <= 0 {
+		//a commission adjustment on a sale already booked.
+		position.realized -= trade.Commission
+		return nil
+	}
+
+	netPerUnit := trade.Price - trade.Commission/trade.Quantity
+	remaining := trade.Quantity
+
+	var disposals []Disposal
+
+	for remaining > quantityEpsilon/2 && len(position.lots) > 0 {
+		index := 0
+		if e.config.Method == CostLIFO {
+			index = len(position.lots) - 1
+		}
+
+		lot := position.lots[index]
+
+		quantity := remaining
+		if lot.Remaining < quantity {
+			quantity = lot.Remaining
+		}
+
+		disposal := Disposal{
+			Market:        position.market.String(),
+			Currency:      position.market.Base(),
+			Quote:         position.market.Quote(),
+			Quantity:      quantity,
+			Proceeds:      quantity * netPerUnit,
+			CostBasis:     quantity * lot.CostPerUnit,
+			Acquired:      lot.Acquired,
+			Disposed:      trade.Time,
+			BuyOrderUUID:  lot.OrderUUID,
+			SellOrderUUID: trade.OrderUUID,
+		}
+		disposal.Gain = disposal.Proceeds - disposal.CostBasis
+		disposals = append(disposals, disposal)
+
+		lot.Remaining -= quantity
+		remaining -= quantity
+
+		if lot.Remaining <= quantityEpsilon/2 {
+			position.lots = append(position.lots[:index], position.lots[index+1:]...)
+		}
+	}
+
+	if remaining > quantityEpsilon/2 {
+		disposal := Disposal{
+			Market:        position.market.String(),
+			Currency:      position.market.Base(),
+			Quote:         position.market.Quote(),
+			Quantity:      remaining,
+			Proceeds:      remaining * netPerUnit,
+			Disposed:      trade.Time,
+			SellOrderUUID: trade.OrderUUID,
+			Unmatched:     true,
+		}
+		disposals = append(disposals, disposal)
+
+		position.unmatched += disposal.Quantity
+		position.unmatchedProceeds += disposal.Proceeds
+	}
+
+	for _, disposal := range disposals {
+		position.realized += disposal.Gain
+	}
+
+	return disposals
+}
+
+func positionSize(position *pnlPosition) decimal {
+	var size decimal
+	for _, lot := range position.lots {
+		size += lot.Remaining
+	}
+
+	return size
+}
+
+func (e *PnLEngine) notify(disposals []Disposal) {
+	e.disposalMutex.RLock()
+	defer e.disposalMutex.RUnlock()
+
+	for _, disposal := range disposals {
+		if e.config.OnDisposal != nil {
+			e.config.OnDisposal(disposal)
+		}
+
+		for _, fn := range e.disposalListeners {
+			fn(disposal)
+		}
+	}
+}
+
+//addDisposalListener call fn for every disposal booked.  the returned func removes the listener.
+func (e *PnLEngine) addDisposalListener(fn func(Disposal)) func() {
+	e.disposalMutex.Lock()
+	defer e.disposalMutex.Unlock()
+
+	e.nextDisposalID++
+	id := e.nextDisposalID
+	e.disposalListeners[id] = fn
+
+	return func() {
+		e.disposalMutex.Lock()
+		delete(e.disposalListeners, id)
+		e.disposalMutex.Unlock()
+	}
+}
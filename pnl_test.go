@@ -0,0 +1,170 @@
+package bittrex
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func closeTo(a, b decimal) bool {
+	return math.Abs(a-b) < 1e-10
+}
+
+//bookTestFills two buys of BTC-LTC, the first with a commission, then a sale of 1.5 at 0.03 less a 0.0003 commission.
+func bookTestFills(t *testing.T, e *PnLEngine, start time.Time) {
+	for i, fill := range []BookedFill{
+		{OrderUUID: "buy1", Market: "BTC-LTC", Side: OrderSideBuy, Quantity: 1, Price: 0.01, Commission: 0.0001},
+		{OrderUUID: "buy2", Market: "BTC-LTC", Side: OrderSideBuy, Quantity: 1, Price: 0.02},
+		{OrderUUID: "sell", Market: "BTC-LTC", Side: OrderSideSell, Quantity: 1.5, Price: 0.03, Commission: 0.0003},
+	} {
+		fill.Time = start.Add(time.Duration(i) * time.Minute)
+		if err := e.RecordFill(fill); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPnLLotMatching(t *testing.T) {
+	type expectedDisposal struct {
+		buy       string
+		quantity  decimal
+		costBasis decimal
+		gain      decimal
+	}
+
+	for _, test := range []struct {
+		method    CostMethod
+		disposals []expectedDisposal
+		lot       string
+		remaining decimal
+		cost      decimal
+		realized  decimal
+	}{
+		{
+			method: CostFIFO,
+			disposals: []expectedDisposal{
+				{"buy1", 1, 0.0101, 0.0298 - 0.0101},
+				{"buy2", 0.5, 0.01, 0.0149 - 0.01},
+			},
+			lot: "buy2", remaining: 0.5, cost: 0.02, realized: 0.0447 - 0.0201,
+		},
+		{
+			method: CostLIFO,
+			disposals: []expectedDisposal{
+				{"buy2", 1, 0.02, 0.0298 - 0.02},
+				{"buy1", 0.5, 0.00505, 0.0149 - 0.00505},
+			},
+			lot: "buy1", remaining: 0.5, cost: 0.0101, realized: 0.0447 - 0.02505,
+		},
+		{
+			method: CostAverage,
+			disposals: []expectedDisposal{
+				{"buy1", 1.5, 0.022575, 0.0447 - 0.022575},
+			},
+			lot: "buy1", remaining: 0.5, cost: 0.01505, realized: 0.0447 - 0.022575,
+		},
+	} {
+		e := NewPnLEngine(nil, PnLConfig{Method: test.method})
+		bookTestFills(t, e, time.Now())
+
+		disposals := e.Disposals()
+		if len(disposals) != len(test.disposals) {
+			t.Fatalf("method %d: expected %d disposals, got %+v", test.method, len(test.disposals), disposals)
+		}
+
+		for i, expected := range test.disposals {
+			got := disposals[i]
+			if got.BuyOrderUUID != expected.buy || got.SellOrderUUID != "sell" || got.Unmatched ||
+				!closeTo(got.Quantity, expected.quantity) || !closeTo(got.CostBasis, expected.costBasis) ||
+				!closeTo(got.Gain, expected.gain) || !closeTo(got.Proceeds, got.Quantity*0.0298) {
+				t.Errorf("method %d: disposal %d expected %+v, got %+v", test.method, i, expected, got)
+			}
+		}
+
+		lots := e.Lots("btc-ltc")
+		if len(lots) != 1 || lots[0].OrderUUID != test.lot || !closeTo(lots[0].Remaining, test.remaining) || !closeTo(lots[0].CostPerUnit, test.cost) {
+			t.Errorf("method %d: expected %s with %v left at %v, got %+v", test.method, test.lot, test.remaining, test.cost, lots)
+		}
+
+		report := e.Report(map[string]decimal{"BTC-LTC": 0.04})
+		if len(report.Markets) != 1 {
+			t.Fatalf("method %d: expected one market, got %+v", test.method, report.Markets)
+		}
+
+		market := report.Markets[0]
+		if !closeTo(market.Realized, test.realized) || !closeTo(report.Realized["BTC"], test.realized) ||
+			!closeTo(market.Position, test.remaining) || !closeTo(market.Unrealized, test.remaining*(0.04-test.cost)) ||
+			!closeTo(market.Commission, 0.0004) || market.Unmarked {
+			t.Errorf("method %d: unexpected report %+v", test.method, market)
+		}
+	}
+}
+
+func TestPnLUnmatchedSaleIsNotRealized(t *testing.T) {
+	e := NewPnLEngine(nil, PnLConfig{Method: CostFIFO})
+
+	c, _ := New("key", "secret")
+	r, riskErr := NewRiskManager(c, RiskLimits{MaxDailyLoss: 1})
+	if riskErr != nil {
+		t.Fatal(riskErr)
+	}
+	defer r.Close()
+	r.UsePnLEngine(e, "BTC")
+
+	now := time.Now()
+
+	//bought at 0.05, then 2 sold at 0.01: half matched at a loss, half with no lot at all.
+	for _, fill := range []BookedFill{
+		{OrderUUID: "buy", Market: "BTC-LTC", Side: OrderSideBuy, Quantity: 1, Price: 0.05, Time: now},
+		{OrderUUID: "sell", Market: "BTC-LTC", Side: OrderSideSell, Quantity: 2, Price: 0.01, Time: now},
+	} {
+		if err := e.RecordFill(fill); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	disposals := e.Disposals()
+	if len(disposals) != 2 || disposals[0].Unmatched || !disposals[1].Unmatched {
+		t.Fatalf("expected a matched and an unmatched disposal, got %+v", disposals)
+	}
+
+	if unmatched := disposals[1]; !closeTo(unmatched.Quantity, 1) || !closeTo(unmatched.Proceeds, 0.01) || unmatched.CostBasis != 0 || unmatched.Gain != 0 {
+		t.Errorf("expected the unmatched disposal to carry proceeds and no gain, got %+v", unmatched)
+	}
+
+	market := e.Report(nil).Markets[0]
+	if !closeTo(market.Realized, -0.04) || !closeTo(market.Unmatched, 1) || !closeTo(market.UnmatchedProceeds, 0.01) {
+		t.Errorf("expected only the matched loss realized, got %+v", market)
+	}
+
+	if daily := r.Stats().DailyPnL; !closeTo(daily, -0.04) {
+		t.Errorf("expected the unmatched proceeds kept out of the daily loss, got %v", daily)
+	}
+}
+
+func TestPnLBookOrderBooksIncrements(t *testing.T) {
+	e := NewPnLEngine(nil, PnLConfig{Method: CostFIFO})
+	now := time.Now()
+
+	//the same order seen three times as it fills: 1 at 0.01, then 1 more at 0.02, then repeated.
+	for _, step := range []struct {
+		filled, pricePerUnit, commission decimal
+	}{
+		{1, 0.01, 0.00001},
+		{2, 0.015, 0.00003},
+		{2, 0.015, 0.00003},
+	} {
+		if err := e.bookOrder("buy", "BTC-LTC", OrderSideBuy, step.filled, step.pricePerUnit, 0, step.commission, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lots := e.Lots("BTC-LTC")
+	if len(lots) != 2 || !closeTo(lots[0].CostPerUnit, 0.01001) || !closeTo(lots[1].CostPerUnit, 0.02002) {
+		t.Errorf("expected each increment booked once at its own price, got %+v", lots)
+	}
+
+	if market := e.Report(nil).Markets[0]; !closeTo(market.Position, 2) || !closeTo(market.Commission, 0.00003) {
+		t.Errorf("unexpected report %+v", market)
+	}
+}
@@ -0,0 +1,392 @@
+package bittrex
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//defaultPortfolioUpdateInterval how often live valuations are published when no UpdateInterval is given.
+const defaultPortfolioUpdateInterval time.Duration = time.Second
+
+//portfolioHops currencies a price may be taken through, alongside the direct market to the quote.
+var portfolioHops = []string{"BTC", "ETH", "USDT"}
+
+//PricingMode which price a balance is valued at.
+type PricingMode int
+
+//Pricing Modes
+const (
+	PriceBid  PricingMode = iota //what the balance would fetch selling now: the bid, or 1 / ask through an inverse market
+	PriceMid                     //halfway between bid and ask
+	PriceLast                    //the last trade
+)
+
+//AssetValuation one currency's share of the portfolio.
+type AssetValuation struct {
+	Currency string
+	Balance  decimal
+	//Price of one unit in the quote currency.
+	Price decimal
+	Value decimal
+	//Allocation percentage of the portfolio's total value.
+	Allocation decimal
+	//Path markets the price was taken from, eg ["BTC-LTC", "USDT-BTC"].  empty for the quote currency itself.
+	Path []string
+	//Spread combined bid/ask spread of the markets in Path, as a fraction of their mid prices.  The path with the
+	//tightest spread is chosen.  zero when a market in Path has no bid or ask.
+	Spread decimal
+	//Unpriced no market path to the quote was found; Value is zero.
+	Unpriced bool
+}
+
+//PortfolioValuation the whole portfolio in one quote currency.
+type PortfolioValuation struct {
+	Quote   string
+	Pricing PricingMode
+	Total   decimal
+	//Assets largest value first.
+	Assets []AssetValuation
+	Time   time.Time
+}
+
+//PortfolioConfig arguments for NewPortfolioValuer.
+type PortfolioConfig struct {
+	//Quote currency to value in, eg BTC, ETH or USDT (as a USD proxy).
+	Quote   string
+	Pricing PricingMode
+
+	//Balances optional live balances.  without one, balances are loaded by Refresh.
+	Balances *BalanceBook
+
+	//UpdateInterval least time between valuations published to subscribers.  defaults to a second.
+	UpdateInterval time.Duration
+}
+
+type portfolioPrice struct {
+	bid, ask, last decimal
+}
+
+/*
+PortfolioValuer values account balances in a single quote currency through Bittrex markets, directly or through BTC,
+ETH or USDT, whichever path has the tightest spread.  Prices are loaded with PublicGetMarketSummaries and kept current
+from the summary deltas when the websocket is connected.
+*/
+type PortfolioValuer struct {
+	client *Client
+	config PortfolioConfig
+
+	mutex    sync.RWMutex
+	prices   map[string]portfolioPrice //market name -> price
+	balances []AccountBalance
+	dirty    bool
+
+	subscriberMutex sync.RWMutex
+	subscribers     []chan PortfolioValuation
+
+	quit           chan struct{}
+	removeListener func()
+	closeOnce      sync.Once
+}
+
+//NewPortfolioValuer load prices (and balances, without a BalanceBook) and start following the summary deltas.
+func NewPortfolioValuer(c *Client, config PortfolioConfig) (*PortfolioValuer, error) {
+	config.Quote = strings.ToUpper(config.Quote)
+	if config.Quote == "" {
+		return nil, fmt.Errorf("NewPortfolioValuer - a quote currency is required")
+	}
+
+	if config.UpdateInterval <= 0 {
+		config.UpdateInterval = defaultPortfolioUpdateInterval
+	}
+
+	p := &PortfolioValuer{
+		client:         c,
+		config:         config,
+		prices:         make(map[string]portfolioPrice),
+		quit:           make(chan struct{}),
+		removeListener: func() {},
+	}
+
+	if refreshErr := p.Refresh(); refreshErr != nil {
+		return nil, refreshErr
+	}
+
+	if c.socket() != nil {
+		remove, listenErr := c.addSummaryListener(p.summaryDelta)
+		if listenErr != nil {
+			return nil, listenErr
+		}
+		p.removeListener = remove
+	}
+
+	go p.run()
+
+	return p, nil
+}
+
+//Refresh reload every market summary, and the balances when no BalanceBook is attached.
+func (p *PortfolioValuer) Refresh() error {
+	summaries, summaryErr := p.client.PublicGetMarketSummaries()
+	if summaryErr != nil {
+		return summaryErr
+	}
+
+	var balances []AccountBalance
+	if p.config.Balances == nil {
+		var balanceErr error
+		if balances, balanceErr = p.client.AccountGetBalances(); balanceErr != nil {
+			return balanceErr
+		}
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for _, summary := range summaries {
+		p.prices[strings.ToUpper(summary.MarketName)] = portfolioPrice{bid: summary.Bid, ask: summary.Ask, last: summary.Last}
+	}
+
+	if p.config.Balances == nil {
+		p.balances = balances
+	}
+
+	p.dirty = true
+
+	return nil
+}
+
+//Subscribe receive a fresh valuation whenever prices or balances change, at most once per UpdateInterval.
+func (p *PortfolioValuer) Subscribe() chan PortfolioValuation {
+	newChan := make(chan PortfolioValuation, 10)
+
+	p.subscriberMutex.Lock()
+	p.subscribers = append(p.subscribers, newChan)
+	p.subscriberMutex.Unlock()
+
+	return newChan
+}
+
+//Close stop following the summary deltas and the BalanceBook.
+func (p *PortfolioValuer) Close() {
+	p.closeOnce.Do(func() {
+		close(p.quit)
+		p.removeListener()
+	})
+}
+
+//Price of one unit of currency in the quote currency, and the markets it was taken from.
+func (p *PortfolioValuer) Price(currency string) (decimal, []string, bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	price, path, _, ok := p.priceLocked(strings.ToUpper(currency))
+	return price, path, ok
+}
+
+//Valuation value every non-zero balance.
+func (p *PortfolioValuer) Valuation() PortfolioValuation {
+	balances := p.currentBalances()
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	valuation := PortfolioValuation{Quote: p.config.Quote, Pricing: p.config.Pricing, Time: time.Now()}
+
+	for _, balance := range balances {
+		if balance.Balance <= 0 {
+			continue
+		}
+
+		asset := AssetValuation{Currency: strings.ToUpper(balance.Currency), Balance: balance.Balance}
+
+		price, path, spread, ok := p.priceLocked(asset.Currency)
+		if ok {
+			asset.Price = price
+			asset.Value = balance.Balance * price
+			asset.Path = path
+			if !math.IsInf(spread, 1) {
+				asset.Spread = spread
+			}
+		} else {
+			asset.Unpriced = true
+		}
+
+		valuation.Total += asset.Value
+		valuation.Assets = append(valuation.Assets, asset)
+	}
+
+	for i := range valuation.Assets {
+		if valuation.Total > 0 {
+			valuation.Assets[i].Allocation = valuation.Assets[i].Value / valuation.Total * 100
+		}
+	}
+
+	sort.Slice(valuation.Assets, func(i, j int) bool {
+		return valuation.Assets[i].Value > valuation.Assets[j].Value
+	})
+
+	return valuation
+}
+
+func (p *PortfolioValuer) currentBalances() []AccountBalance {
+	if p.config.Balances != nil {
+		return p.config.Balances.Balances()
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.balances
+}
+
+/*
+priceLocked the price through the direct market or any hop, choosing the path whose markets have the tightest combined
+spread.  A path with a market missing its bid or ask only wins when no other path is priced; among those the direct
+market is preferred.  must be called with the mutex held.
+*/
+func (p *PortfolioValuer) priceLocked(currency string) (decimal, []string, decimal, bool) {
+	quote := p.config.Quote
+
+	if currency == quote {
+		return 1, nil, 0, true
+	}
+
+	var best decimal
+	var bestPath []string
+	bestSpread := math.Inf(1)
+	found := false
+
+	consider := func(rate decimal, path []string, spread decimal) {
+		if !found || spread < bestSpread {
+			best, bestPath, bestSpread, found = rate, path, spread, true
+		}
+	}
+
+	if rate, market, spread, ok := p.rateLocked(currency, quote); ok {
+		consider(rate, []string{market}, spread)
+	}
+
+	for _, hop := range portfolioHops {
+		if hop == currency || hop == quote {
+			continue
+		}
+
+		first, firstMarket, firstSpread, firstOK := p.rateLocked(currency, hop)
+		if !firstOK {
+			continue
+		}
+
+		second, secondMarket, secondSpread, secondOK := p.rateLocked(hop, quote)
+		if !secondOK {
+			continue
+		}
+
+		consider(first*second, []string{firstMarket, secondMarket}, firstSpread+secondSpread)
+	}
+
+	return best, bestPath, bestSpread, found
+}
+
+/*
+rateLocked units of to received per unit of from through a single market, in either direction, and the market's
+spread as a fraction of its mid price.  The spread is infinite when the bid or ask is missing.
+*/
+func (p *PortfolioValuer) rateLocked(from, to string) (decimal, string, decimal, bool) {
+	//bittrex names markets QUOTE-BASE, and prices are in the quote currency.
+	if price, ok := p.prices[to+"-"+from]; ok {
+		rate := price.last
+		switch p.config.Pricing {
+		case PriceBid:
+			rate = price.bid
+		case PriceMid:
+			rate = (price.bid + price.ask) / 2
+		}
+
+		return rate, to + "-" + from, price.spread(), rate > 0
+	}
+
+	if price, ok := p.prices[from+"-"+to]; ok {
+		//buying to with from, so selling now pays the ask.
+		rate := price.last
+		switch p.config.Pricing {
+		case PriceBid:
+			rate = price.ask
+		case PriceMid:
+			rate = (price.bid + price.ask) / 2
+		}
+
+		if rate <= 0 {
+			return 0, "", 0, false
+		}
+
+		return 1 / rate, from + "-" + to, price.spread(), true
+	}
+
+	return 0, "", 0, false
+}
+
+//spread ask less bid as a fraction of the mid price, or infinity without a sensible bid and ask.
+func (price portfolioPrice) spread() decimal {
+	if price.bid <= 0 || price.ask < price.bid {
+		return math.Inf(1)
+	}
+
+	return (price.ask - price.bid) / ((price.ask + price.bid) / 2)
+}
+
+func (p *PortfolioValuer) summaryDelta(summary socketPayloads.Summary) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.prices[strings.ToUpper(summary.MarketName)] = portfolioPrice{bid: summary.Bid, ask: summary.Ask, last: summary.Last}
+	p.dirty = true
+}
+
+func (p *PortfolioValuer) run() {
+	ticker := time.NewTicker(p.config.UpdateInterval)
+	defer ticker.Stop()
+
+	var balanceChanges chan BalanceChange
+	if p.config.Balances != nil {
+		balanceChanges = p.config.Balances.Subscribe()
+		defer p.config.Balances.Unsubscribe(balanceChanges)
+	}
+
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-balanceChanges:
+			p.mutex.Lock()
+			p.dirty = true
+			p.mutex.Unlock()
+		case <-ticker.C:
+			p.mutex.Lock()
+			dirty := p.dirty
+			p.dirty = false
+			p.mutex.Unlock()
+
+			if dirty {
+				p.publish(p.Valuation())
+			}
+		}
+	}
+}
+
+func (p *PortfolioValuer) publish(valuation PortfolioValuation) {
+	p.subscriberMutex.RLock()
+	defer p.subscriberMutex.RUnlock()
+
+	for _, ch := range p.subscribers {
+		select {
+		case ch <- valuation:
+		default:
+		}
+	}
+}
@@ -0,0 +1,654 @@
+package bittrex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//defaultMaxPriceAge how old a cached reference price may be before it is fetched again over REST.
+const defaultMaxPriceAge time.Duration = 30 * time.Second
+
+//RiskRule the pre-trade check an order failed.
+type RiskRule string
+
+//Risk Rules
+const (
+	RiskMaxNotional   RiskRule = "MAX_NOTIONAL"
+	RiskMaxPosition   RiskRule = "MAX_POSITION"
+	RiskMaxOpenOrders RiskRule = "MAX_OPEN_ORDERS"
+	RiskPriceBand     RiskRule = "PRICE_BAND"
+	RiskOrderRate     RiskRule = "ORDER_RATE"
+	RiskDailyLoss     RiskRule = "DAILY_LOSS"
+)
+
+//PriceReference what the price band is measured from.
+type PriceReference int
+
+//Price References
+const (
+	PriceFromBidAsk PriceReference = iota //buys against the best ask, sells against the best bid
+	PriceFromLast                         //the last trade
+)
+
+//RiskViolationError returned by the order methods when an order fails a pre-trade check.
+type RiskViolationError struct {
+	Rule     RiskRule
+	Market   string
+	Currency string
+	Limit    decimal
+	Value    decimal
+	Reason   string
+}
+
+func (e RiskViolationError) Error() string {
+	subject := e.Market
+	if e.Currency != "" {
+		subject = e.Currency
+	}
+
+	if e.Reason != "" {
+		return fmt.Sprintf("risk check %s failed for %s - %s", e.Rule, subject, e.Reason)
+	}
+
+	return fmt.Sprintf("risk check %s failed for %s - %.8f exceeds limit %.8f", e.Rule, subject, e.Value, e.Limit)
+}
+
+/*
+RiskLimits configuration for a RiskManager.  A zero value disables that check.
+Notionals are in the market's quote currency (BTC for BTC-LTC).
+*/
+type RiskLimits struct {
+	//MaxNotional largest quantity * rate for a single order, by market name.
+	MaxNotional map[string]decimal
+	//DefaultMaxNotional used for markets missing from MaxNotional.
+	DefaultMaxNotional decimal
+
+	//MaxPosition largest total balance of a currency that an order may leave the account holding.
+	MaxPosition map[string]decimal
+
+	//MaxOpenOrders across every market.
+	MaxOpenOrders int
+
+	//PriceBand how far past the reference price an order may be priced, as a fraction (0.05 = 5%).
+	PriceBand      decimal
+	PriceReference PriceReference
+	//MaxPriceAge reference prices older than this are fetched with PublicGetMarketSummary.  defaults to 30 seconds.
+	MaxPriceAge time.Duration
+
+	//MaxOrdersPerMinute orders sent in any rolling minute, not counting those the exchange refused.
+	MaxOrdersPerMinute int
+
+	//MaxDailyLoss realized loss, as reported to RecordRealizedPnL or by a PnLEngine attached with UsePnLEngine, after
+	//which orders are refused until midnight UTC.
+	MaxDailyLoss decimal
+}
+
+//RiskStats counters for metrics.
+type RiskStats struct {
+	Checks     int64
+	Violations map[RiskRule]int64
+	OpenOrders int
+	DailyPnL   decimal
+}
+
+/*
+riskReservation the room an order passing the checks holds against the limits: a submission, an open order, and the
+amount it adds to a position.  Until it is placed or refused, orders checked alongside it are measured as if it were
+already open.
+*/
+type riskReservation struct {
+	manager   *RiskManager
+	submitted time.Time
+	currency  string
+	amount    decimal
+	remaining decimal //fraction of amount the order has yet to fill
+}
+
+type riskPrice struct {
+	bid, ask, last decimal
+	updated        time.Time
+}
+
+/*
+RiskManager pre-trade risk checks run by MarketBuyLimit, MarketSellLimit, KeyMarketTrade* and PlaceOrder once
+attached with UseRiskManager.  Balances and open orders are loaded when the manager is created and kept current from
+the authenticated socket.
+*/
+type RiskManager struct {
+	client *Client
+	limits RiskLimits
+
+	mutex       sync.Mutex
+	balances    map[string]decimal
+	openOrders  map[string]bool
+	closed      map[string]time.Time //orders whose delta may beat orderPlaced
+	reserved    map[*riskReservation]bool
+	positions   map[string]*riskReservation //open order id -> what it may still add to a position
+	prices      map[string]riskPrice
+	books       map[string]*LocalOrderBook
+	balanceBook *BalanceBook
+	submissions []time.Time
+	lossDay     string
+	dailyPnL    decimal
+	checks      int64
+	violations  map[RiskRule]int64
+
+	removeListeners []func()
+}
+
+//NewRiskManager load the state the limits depend on and start tracking it.
+func NewRiskManager(c *Client, limits RiskLimits) (*RiskManager, error) {
+	if limits.PriceBand < 0 || limits.MaxDailyLoss < 0 || limits.DefaultMaxNotional < 0 {
+		return nil, fmt.Errorf("NewRiskManager - limits cannot be negative")
+	}
+
+	if limits.MaxPriceAge <= 0 {
+		limits.MaxPriceAge = defaultMaxPriceAge
+	}
+
+	r := &RiskManager{
+		client:     c,
+		limits:     limits,
+		balances:   make(map[string]decimal),
+		openOrders: make(map[string]bool),
+		closed:     make(map[string]time.Time),
+		reserved:   make(map[*riskReservation]bool),
+		positions:  make(map[string]*riskReservation),
+		prices:     make(map[string]riskPrice),
+		books:      make(map[string]*LocalOrderBook),
+		violations: make(map[RiskRule]int64),
+	}
+
+	if len(limits.MaxPosition) > 0 {
+		balances, balanceErr := c.AccountGetBalances()
+		if balanceErr != nil {
+			return nil, balanceErr
+		}
+
+		for _, balance := range balances {
+			r.balances[strings.ToUpper(balance.Currency)] = balance.Balance
+		}
+	}
+
+	if limits.MaxOpenOrders > 0 {
+		orders, openErr := c.MarketGetOpenOrders("")
+		if openErr != nil {
+			return nil, openErr
+		}
+
+		for _, order := range orders {
+			r.openOrders[order.OrderUUID] = true
+		}
+	}
+
+	r.removeListeners = append(r.removeListeners, c.addOrderListener(r.orderDelta), c.addBalanceListener(r.balanceDelta))
+
+	if limits.PriceBand > 0 && c.socket() != nil {
+		removeSummary, listenErr := c.addSummaryListener(r.summaryDelta)
+		if listenErr != nil {
+			r.Close()
+			return nil, listenErr
+		}
+
+		r.removeListeners = append(r.removeListeners, removeSummary)
+	}
+
+	return r, nil
+}
+
+//UseRiskManager check every order against r before it is sent.  Pass nil to stop checking.
+func (c *Client) UseRiskManager(r *RiskManager) {
+	c.attachMutex.Lock()
+	defer c.attachMutex.Unlock()
+
+	c.riskManager = r
+}
+
+//attachedRiskManager the risk manager checking the client's orders, if any.
+func (c *Client) attachedRiskManager() *RiskManager {
+	c.attachMutex.RLock()
+	defer c.attachMutex.RUnlock()
+
+	return c.riskManager
+}
+
+//UseOrderBook take the best bid and ask for the book's market from a LocalOrderBook instead of the summaries.
+func (r *RiskManager) UseOrderBook(book *LocalOrderBook) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.books[strings.ToUpper(book.Market())] = book
+}
+
+//UseBalanceBook take positions from a BalanceBook instead of the manager's own tracking.
+func (r *RiskManager) UseBalanceBook(book *BalanceBook) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.balanceBook = book
+}
+
+/*
+UsePnLEngine add the realized gain or loss of every sale the engine books to today's total, so MaxDailyLoss needs no
+calls to RecordRealizedPnL.  The limit is a single amount, so only sales in markets quoted in quote are counted; an
+empty quote counts every market.  Sales dated before today (UTC), such as those booked by LoadHistory, are ignored,
+as are sales with no lot to match them, whose gain is unknown.
+*/
+func (r *RiskManager) UsePnLEngine(engine *PnLEngine, quote string) {
+	remove := engine.addDisposalListener(func(disposal Disposal) {
+		r.recordDisposal(disposal, quote)
+	})
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.removeListeners = append(r.removeListeners, remove)
+}
+
+//Close stop tracking balances, orders, prices and realized pnl.
+func (r *RiskManager) Close() {
+	r.mutex.Lock()
+	removeListeners := r.removeListeners
+	r.removeListeners = nil
+	r.mutex.Unlock()
+
+	for _, remove := range removeListeners {
+		remove()
+	}
+}
+
+/*
+RecordRealizedPnL add a realized profit (positive) or loss (negative) to today's total.
+The total resets at midnight UTC.
+*/
+func (r *RiskManager) RecordRealizedPnL(pnl decimal) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rollDayLocked(time.Now())
+	r.dailyPnL += pnl
+}
+
+//Stats check and violation counters since the manager was created.
+func (r *RiskManager) Stats() RiskStats {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rollDayLocked(time.Now())
+
+	stats := RiskStats{
+		Checks:     r.checks,
+		Violations: make(map[RiskRule]int64, len(r.violations)),
+		OpenOrders: len(r.openOrders),
+		DailyPnL:   r.dailyPnL,
+	}
+
+	for rule, count := range r.violations {
+		stats.Violations[rule] = count
+	}
+
+	return stats
+}
+
+/*
+Check run every configured check against an order without sending it.  A zero rate (a market order) is valued at
+the reference price.  Open orders sent through the client count towards MaxPosition for what they have yet to fill.
+*/
+func (r *RiskManager) Check(market string, side OrderSide, quantity, rate decimal) error {
+	_, checkErr := r.check(market, side, quantity, rate, false)
+	return checkErr
+}
+
+/*
+reserve Check an order about to be sent, holding its place against MaxOrdersPerMinute, MaxOpenOrders and MaxPosition
+in the same step, so orders sent at once can't all pass before any of them is counted.  The reservation is ended by
+orderPlaced or release.
+*/
+func (r *RiskManager) reserve(market string, side OrderSide, quantity, rate decimal) (*riskReservation, error) {
+	return r.check(market, side, quantity, rate, true)
+}
+
+func (r *RiskManager) check(market string, side OrderSide, quantity, rate decimal, reserve bool) (*riskReservation, error) {
+	market = strings.ToUpper(market)
+
+	var reference decimal
+	if r.limits.PriceBand > 0 || (rate <= 0 && r.needsNotional()) {
+		var priceErr error
+		if reference, priceErr = r.referencePrice(market, side); priceErr != nil {
+			rule := RiskMaxNotional
+			if r.limits.PriceBand > 0 {
+				rule = RiskPriceBand
+			}
+
+			return nil, r.violation(RiskViolationError{Rule: rule, Market: market, Reason: priceErr.Error()})
+		}
+	}
+
+	price := rate
+	if price <= 0 {
+		price = reference
+	}
+
+	now := time.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.checks++
+	r.rollDayLocked(now)
+
+	if limit := r.limits.MaxDailyLoss; limit > 0 && -r.dailyPnL >= limit {
+		return nil, r.violationLocked(RiskViolationError{Rule: RiskDailyLoss, Market: market, Limit: limit, Value: -r.dailyPnL})
+	}
+
+	cutoff := now.Add(-time.Minute)
+	for len(r.submissions) > 0 && r.submissions[0].Before(cutoff) {
+		r.submissions = r.submissions[1:]
+	}
+
+	if limit := r.limits.MaxOrdersPerMinute; limit > 0 && len(r.submissions) >= limit {
+		return nil, r.violationLocked(RiskViolationError{Rule: RiskOrderRate, Market: market, Limit: decimal(limit), Value: decimal(len(r.submissions) + 1)})
+	}
+
+	open := len(r.openOrders) + len(r.reserved)
+	if limit := r.limits.MaxOpenOrders; limit > 0 && open >= limit {
+		return nil, r.violationLocked(RiskViolationError{Rule: RiskMaxOpenOrders, Market: market, Limit: decimal(limit), Value: decimal(open + 1)})
+	}
+
+	notional := quantity * price
+
+	limit, ok := r.limits.MaxNotional[market]
+	if !ok {
+		limit = r.limits.DefaultMaxNotional
+	}
+
+	if limit > 0 && notional > limit {
+		return nil, r.violationLocked(RiskViolationError{Rule: RiskMaxNotional, Market: market, Limit: limit, Value: notional})
+	}
+
+	var currency string
+	var amount decimal
+
+	if len(r.limits.MaxPosition) > 0 {
+		if parsed, parseErr := ParseMarket(market); parseErr == nil {
+			//the currency the order buys, and how much of it.
+			currency, amount = parsed.Base(), quantity
+			if side == OrderSideSell {
+				currency, amount = parsed.Quote(), notional
+			}
+
+			held := r.balances[currency]
+			if r.balanceBook != nil {
+				held = r.balanceBook.Total(currency)
+			}
+
+			held += r.pendingPositionLocked(currency)
+
+			if limit, ok := r.limits.MaxPosition[currency]; ok && held+amount > limit {
+				return nil, r.violationLocked(RiskViolationError{Rule: RiskMaxPosition, Market: market, Currency: currency, Limit: limit, Value: held + amount})
+			}
+		}
+	}
+
+	if band := r.limits.PriceBand; band > 0 && rate > 0 {
+		if side == OrderSideBuy && rate > reference*(1+band) {
+			return nil, r.violationLocked(RiskViolationError{
+				Rule:   RiskPriceBand,
+				Market: market,
+				Limit:  reference * (1 + band),
+				Value:  rate,
+				Reason: fmt.Sprintf("buy rate %.8f is above the band ceiling %.8f", rate, reference*(1+band)),
+			})
+		}
+
+		if side == OrderSideSell && rate < reference*(1-band) {
+			return nil, r.violationLocked(RiskViolationError{
+				Rule:   RiskPriceBand,
+				Market: market,
+				Limit:  reference * (1 - band),
+				Value:  rate,
+				Reason: fmt.Sprintf("sell rate %.8f is below the band floor %.8f", rate, reference*(1-band)),
+			})
+		}
+	}
+
+	if !reserve {
+		return nil, nil
+	}
+
+	reservation := &riskReservation{manager: r, submitted: now, currency: currency, amount: amount, remaining: 1}
+	r.submissions = append(r.submissions, now)
+	r.reserved[reservation] = true
+
+	return reservation, nil
+}
+
+//pendingPositionLocked what reserved and open orders may still add to a currency.  must be called with the mutex held.
+func (r *RiskManager) pendingPositionLocked(currency string) decimal {
+	var pending decimal
+
+	for reservation := range r.reserved {
+		if reservation.currency == currency {
+			pending += reservation.amount
+		}
+	}
+
+	for _, reservation := range r.positions {
+		if reservation.currency == currency {
+			pending += reservation.amount * reservation.remaining
+		}
+	}
+
+	return pending
+}
+
+func (r *RiskManager) needsNotional() bool {
+	return r.limits.DefaultMaxNotional > 0 || len(r.limits.MaxNotional) > 0 || len(r.limits.MaxPosition) > 0
+}
+
+func (r *RiskManager) violation(err RiskViolationError) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.checks++
+	return r.violationLocked(err)
+}
+
+func (r *RiskManager) violationLocked(err RiskViolationError) error {
+	r.violations[err.Rule]++
+	return err
+}
+
+//rollDayLocked reset the daily pnl when the UTC date changes.  must be called with the mutex held.
+func (r *RiskManager) rollDayLocked(now time.Time) {
+	day := now.UTC().Format("2006-01-02")
+	if day != r.lossDay {
+		r.lossDay = day
+		r.dailyPnL = 0
+	}
+}
+
+//referencePrice the price an order on side is measured against: an attached book, a fresh summary, or REST.
+func (r *RiskManager) referencePrice(market string, side OrderSide) (decimal, error) {
+	r.mutex.Lock()
+	book := r.books[market]
+	cached, ok := r.prices[market]
+	r.mutex.Unlock()
+
+	if book != nil && r.limits.PriceReference == PriceFromBidAsk && book.Synced() {
+		if level, found := r.bookLevel(book, side); found {
+			return level, nil
+		}
+	}
+
+	if !ok || time.Since(cached.updated) > r.limits.MaxPriceAge {
+		summary, summaryErr := r.client.PublicGetMarketSummary(market)
+		if summaryErr != nil {
+			return 0, fmt.Errorf("no reference price: %s", summaryErr.Error())
+		}
+
+		cached = riskPrice{bid: summary.Bid, ask: summary.Ask, last: summary.Last, updated: time.Now()}
+
+		r.mutex.Lock()
+		r.prices[market] = cached
+		r.mutex.Unlock()
+	}
+
+	price := cached.last
+	if r.limits.PriceReference == PriceFromBidAsk {
+		price = cached.bid
+		if side == OrderSideBuy {
+			price = cached.ask
+		}
+	}
+
+	if price <= 0 {
+		return 0, fmt.Errorf("no reference price for %s", market)
+	}
+
+	return price, nil
+}
+
+func (r *RiskManager) bookLevel(book *LocalOrderBook, side OrderSide) (decimal, bool) {
+	if side == OrderSideBuy {
+		ask, found := book.BestAsk()
+		return ask.Rate, found
+	}
+
+	bid, found := book.BestBid()
+	return bid.Rate, found
+}
+
+func (r *RiskManager) orderDelta(delta socketPayloads.OrderResponse) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	switch delta.Type {
+	case socketPayloads.OrderDeltaOpen, socketPayloads.OrderDeltaPartial:
+		r.openOrders[delta.Order.OrderUUID] = true
+
+		//what has filled is in the balances now.
+		if reservation, ok := r.positions[delta.Order.OrderUUID]; ok && delta.Order.Quantity > 0 {
+			reservation.remaining = delta.Order.QuantityRemaining / delta.Order.Quantity
+		}
+	default:
+		delete(r.openOrders, delta.Order.OrderUUID)
+		delete(r.positions, delta.Order.OrderUUID)
+		r.closed[delta.Order.OrderUUID] = time.Now()
+	}
+}
+
+func (r *RiskManager) balanceDelta(balance socketPayloads.Balance) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.balances[strings.ToUpper(balance.Currency)] = balance.Balance
+}
+
+//recordDisposal add a sale booked by an attached PnLEngine to today's total.
+func (r *RiskManager) recordDisposal(disposal Disposal, quote string) {
+	if disposal.Unmatched || (quote != "" && !strings.EqualFold(disposal.Quote, quote)) {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.rollDayLocked(time.Now())
+
+	if disposal.Disposed.UTC().Format("2006-01-02") != r.lossDay {
+		return
+	}
+
+	r.dailyPnL += disposal.Gain
+}
+
+func (r *RiskManager) summaryDelta(summary socketPayloads.Summary) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.prices[strings.ToUpper(summary.MarketName)] = riskPrice{
+		bid:     summary.Bid,
+		ask:     summary.Ask,
+		last:    summary.Last,
+		updated: time.Now(),
+	}
+}
+
+/*
+orderPlaced turn an accepted order's reservation into an open order, counted ahead of its order delta.  orderID is
+empty when the exchange's response could not be read, in which case only the submission stays counted.
+*/
+func (r *RiskManager) orderPlaced(reservation *riskReservation, orderID string) {
+	now := time.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.reserved, reservation)
+
+	if orderID == "" {
+		return
+	}
+
+	cutoff := now.Add(-time.Minute)
+	for id, at := range r.closed {
+		if at.Before(cutoff) {
+			delete(r.closed, id)
+		}
+	}
+
+	if _, done := r.closed[orderID]; !done {
+		r.openOrders[orderID] = true
+
+		if reservation.amount > 0 {
+			r.positions[orderID] = reservation
+		}
+	}
+}
+
+//release give back the room held by an order that was refused, or never reached the exchange.
+func (r *RiskManager) release(reservation *riskReservation) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.reserved, reservation)
+
+	for i := len(r.submissions) - 1; i >= 0; i-- {
+		if r.submissions[i].Equal(reservation.submitted) {
+			r.submissions = append(r.submissions[:i], r.submissions[i+1:]...)
+			break
+		}
+	}
+}
+
+/*
+checkRisk consult the attached risk manager, if any, before an order is sent.  A passing order holds its place
+against the limits until riskOrderPlaced or riskOrderFailed is called with the reservation.
+*/
+func (c *Client) checkRisk(market string, side OrderSide, quantity, rate decimal) (*riskReservation, error) {
+	r := c.attachedRiskManager()
+	if r == nil {
+		return nil, nil
+	}
+
+	return r.reserve(market, side, quantity, rate)
+}
+
+//riskOrderPlaced tell the risk manager that reserved an order, if any, that the exchange accepted it.
+func (c *Client) riskOrderPlaced(reservation *riskReservation, orderID string) {
+	if reservation != nil {
+		reservation.manager.orderPlaced(reservation, orderID)
+	}
+}
+
+//riskOrderFailed tell the risk manager that reserved an order, if any, that it was not placed.
+func (c *Client) riskOrderFailed(reservation *riskReservation) {
+	if reservation != nil {
+		reservation.manager.release(reservation)
+	}
+}
@@ -0,0 +1,1102 @@
+// The bittrex Client API over gRPC.  Messages mirror the library's types; rates and quantities are doubles, as they
+// are float64 in Go.  Errors use status codes: FAILED_PRECONDITION when the exchange refused the call (success
+// false), INVALID_ARGUMENT when the request was rejected locally before being sent, PERMISSION_DENIED when the server
+// does not serve the call or the caller is not authorized for it, DATA_LOSS when an order was placed but the
+// exchange's response could not be read, so it must not be retried, RESOURCE_EXHAUSTED when a stream fell too far
+// behind, and UNKNOWN otherwise.  Servers answer public calls only unless configured to serve account, trading or
+// withdrawal calls.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: bittrex.proto
+
+package bittrexpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Bittrex_GetMarkets_FullMethodName           = "/bittrex.v1.Bittrex/GetMarkets"
+	Bittrex_GetCurrencies_FullMethodName        = "/bittrex.v1.Bittrex/GetCurrencies"
+	Bittrex_GetTicker_FullMethodName            = "/bittrex.v1.Bittrex/GetTicker"
+	Bittrex_GetMarketSummaries_FullMethodName   = "/bittrex.v1.Bittrex/GetMarketSummaries"
+	Bittrex_GetMarketSummary_FullMethodName     = "/bittrex.v1.Bittrex/GetMarketSummary"
+	Bittrex_GetOrderBook_FullMethodName         = "/bittrex.v1.Bittrex/GetOrderBook"
+	Bittrex_GetMarketHistory_FullMethodName     = "/bittrex.v1.Bittrex/GetMarketHistory"
+	Bittrex_GetBalances_FullMethodName          = "/bittrex.v1.Bittrex/GetBalances"
+	Bittrex_GetBalance_FullMethodName           = "/bittrex.v1.Bittrex/GetBalance"
+	Bittrex_GetDepositAddress_FullMethodName    = "/bittrex.v1.Bittrex/GetDepositAddress"
+	Bittrex_Withdraw_FullMethodName             = "/bittrex.v1.Bittrex/Withdraw"
+	Bittrex_GetOrder_FullMethodName             = "/bittrex.v1.Bittrex/GetOrder"
+	Bittrex_GetOrderHistory_FullMethodName      = "/bittrex.v1.Bittrex/GetOrderHistory"
+	Bittrex_GetWithdrawalHistory_FullMethodName = "/bittrex.v1.Bittrex/GetWithdrawalHistory"
+	Bittrex_GetDepositHistory_FullMethodName    = "/bittrex.v1.Bittrex/GetDepositHistory"
+	Bittrex_BuyLimit_FullMethodName             = "/bittrex.v1.Bittrex/BuyLimit"
+	Bittrex_SellLimit_FullMethodName            = "/bittrex.v1.Bittrex/SellLimit"
+	Bittrex_Cancel_FullMethodName               = "/bittrex.v1.Bittrex/Cancel"
+	Bittrex_GetOpenOrders_FullMethodName        = "/bittrex.v1.Bittrex/GetOpenOrders"
+	Bittrex_GetTicks_FullMethodName             = "/bittrex.v1.Bittrex/GetTicks"
+	Bittrex_GetLatestTick_FullMethodName        = "/bittrex.v1.Bittrex/GetLatestTick"
+	Bittrex_PlaceOrder_FullMethodName           = "/bittrex.v1.Bittrex/PlaceOrder"
+	Bittrex_SubscribeSummaries_FullMethodName   = "/bittrex.v1.Bittrex/SubscribeSummaries"
+	Bittrex_SubscribeExchange_FullMethodName    = "/bittrex.v1.Bittrex/SubscribeExchange"
+	Bittrex_SubscribeOrders_FullMethodName      = "/bittrex.v1.Bittrex/SubscribeOrders"
+	Bittrex_SubscribeBalances_FullMethodName    = "/bittrex.v1.Bittrex/SubscribeBalances"
+)
+
+// BittrexClient is the client API for Bittrex service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BittrexClient interface {
+	// v1.1 public
+	GetMarkets(ctx context.Context, in *GetMarketsRequest, opts ...grpc.CallOption) (*GetMarketsResponse, error)
+	GetCurrencies(ctx context.Context, in *GetCurrenciesRequest, opts ...grpc.CallOption) (*GetCurrenciesResponse, error)
+	GetTicker(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*Ticker, error)
+	GetMarketSummaries(ctx context.Context, in *GetMarketSummariesRequest, opts ...grpc.CallOption) (*GetMarketSummariesResponse, error)
+	GetMarketSummary(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketSummary, error)
+	GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*OrderBook, error)
+	GetMarketHistory(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error)
+	// v1.1 account
+	GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error)
+	GetBalance(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*AccountBalance, error)
+	GetDepositAddress(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*WalletAddress, error)
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*TransactionID, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*AccountOrder, error)
+	GetOrderHistory(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	GetWithdrawalHistory(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*GetTransferHistoryResponse, error)
+	GetDepositHistory(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*GetTransferHistoryResponse, error)
+	// v1.1 market
+	BuyLimit(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*TransactionID, error)
+	SellLimit(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*TransactionID, error)
+	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	GetOpenOrders(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*GetOpenOrdersResponse, error)
+	// v2.0 market history and trade.  TradeBuy and TradeSell of the Go client are PlaceOrder calls.
+	GetTicks(ctx context.Context, in *GetTicksRequest, opts ...grpc.CallOption) (*GetTicksResponse, error)
+	GetLatestTick(ctx context.Context, in *GetTicksRequest, opts ...grpc.CallOption) (*Candle, error)
+	PlaceOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*PlacedOrder, error)
+	// socket subscriptions.  Every stream sees every delta; no markets means all markets.
+	SubscribeSummaries(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MarketSummary], error)
+	SubscribeExchange(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExchangeDelta], error)
+	SubscribeOrders(ctx context.Context, in *SubscribeOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderDelta], error)
+	SubscribeBalances(ctx context.Context, in *SubscribeBalancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BalanceDelta], error)
+}
+
+type bittrexClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBittrexClient(cc grpc.ClientConnInterface) BittrexClient {
+	return &bittrexClient{cc}
+}
+
+func (c *bittrexClient) GetMarkets(ctx context.Context, in *GetMarketsRequest, opts ...grpc.CallOption) (*GetMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketsResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetCurrencies(ctx context.Context, in *GetCurrenciesRequest, opts ...grpc.CallOption) (*GetCurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrenciesResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetTicker(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*Ticker, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ticker)
+	err := c.cc.Invoke(ctx, Bittrex_GetTicker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetMarketSummaries(ctx context.Context, in *GetMarketSummariesRequest, opts ...grpc.CallOption) (*GetMarketSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketSummariesResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetMarketSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetMarketSummary(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketSummary)
+	err := c.cc.Invoke(ctx, Bittrex_GetMarketSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetOrderBook(ctx context.Context, in *GetOrderBookRequest, opts ...grpc.CallOption) (*OrderBook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderBook)
+	err := c.cc.Invoke(ctx, Bittrex_GetOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetMarketHistory(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*GetMarketHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMarketHistoryResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetMarketHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetBalances(ctx context.Context, in *GetBalancesRequest, opts ...grpc.CallOption) (*GetBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalancesResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetBalance(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*AccountBalance, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountBalance)
+	err := c.cc.Invoke(ctx, Bittrex_GetBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetDepositAddress(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*WalletAddress, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletAddress)
+	err := c.cc.Invoke(ctx, Bittrex_GetDepositAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*TransactionID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionID)
+	err := c.cc.Invoke(ctx, Bittrex_Withdraw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*AccountOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountOrder)
+	err := c.cc.Invoke(ctx, Bittrex_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetOrderHistory(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetWithdrawalHistory(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*GetTransferHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferHistoryResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetWithdrawalHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetDepositHistory(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*GetTransferHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransferHistoryResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetDepositHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) BuyLimit(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*TransactionID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionID)
+	err := c.cc.Invoke(ctx, Bittrex_BuyLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) SellLimit(ctx context.Context, in *LimitOrderRequest, opts ...grpc.CallOption) (*TransactionID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionID)
+	err := c.cc.Invoke(ctx, Bittrex_SellLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, Bittrex_Cancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetOpenOrders(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*GetOpenOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOpenOrdersResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetOpenOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetTicks(ctx context.Context, in *GetTicksRequest, opts ...grpc.CallOption) (*GetTicksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicksResponse)
+	err := c.cc.Invoke(ctx, Bittrex_GetTicks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) GetLatestTick(ctx context.Context, in *GetTicksRequest, opts ...grpc.CallOption) (*Candle, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Candle)
+	err := c.cc.Invoke(ctx, Bittrex_GetLatestTick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) PlaceOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*PlacedOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacedOrder)
+	err := c.cc.Invoke(ctx, Bittrex_PlaceOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bittrexClient) SubscribeSummaries(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MarketSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Bittrex_ServiceDesc.Streams[0], Bittrex_SubscribeSummaries_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, MarketSummary]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeSummariesClient = grpc.ServerStreamingClient[MarketSummary]
+
+func (c *bittrexClient) SubscribeExchange(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExchangeDelta], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Bittrex_ServiceDesc.Streams[1], Bittrex_SubscribeExchange_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, ExchangeDelta]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeExchangeClient = grpc.ServerStreamingClient[ExchangeDelta]
+
+func (c *bittrexClient) SubscribeOrders(ctx context.Context, in *SubscribeOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderDelta], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Bittrex_ServiceDesc.Streams[2], Bittrex_SubscribeOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeOrdersRequest, OrderDelta]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeOrdersClient = grpc.ServerStreamingClient[OrderDelta]
+
+func (c *bittrexClient) SubscribeBalances(ctx context.Context, in *SubscribeBalancesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BalanceDelta], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Bittrex_ServiceDesc.Streams[3], Bittrex_SubscribeBalances_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeBalancesRequest, BalanceDelta]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeBalancesClient = grpc.ServerStreamingClient[BalanceDelta]
+
+// BittrexServer is the server API for Bittrex service.
+// All implementations must embed UnimplementedBittrexServer
+// for forward compatibility.
+type BittrexServer interface {
+	// v1.1 public
+	GetMarkets(context.Context, *GetMarketsRequest) (*GetMarketsResponse, error)
+	GetCurrencies(context.Context, *GetCurrenciesRequest) (*GetCurrenciesResponse, error)
+	GetTicker(context.Context, *MarketRequest) (*Ticker, error)
+	GetMarketSummaries(context.Context, *GetMarketSummariesRequest) (*GetMarketSummariesResponse, error)
+	GetMarketSummary(context.Context, *MarketRequest) (*MarketSummary, error)
+	GetOrderBook(context.Context, *GetOrderBookRequest) (*OrderBook, error)
+	GetMarketHistory(context.Context, *MarketRequest) (*GetMarketHistoryResponse, error)
+	// v1.1 account
+	GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error)
+	GetBalance(context.Context, *CurrencyRequest) (*AccountBalance, error)
+	GetDepositAddress(context.Context, *CurrencyRequest) (*WalletAddress, error)
+	Withdraw(context.Context, *WithdrawRequest) (*TransactionID, error)
+	GetOrder(context.Context, *GetOrderRequest) (*AccountOrder, error)
+	GetOrderHistory(context.Context, *MarketRequest) (*GetOrderHistoryResponse, error)
+	GetWithdrawalHistory(context.Context, *CurrencyRequest) (*GetTransferHistoryResponse, error)
+	GetDepositHistory(context.Context, *CurrencyRequest) (*GetTransferHistoryResponse, error)
+	// v1.1 market
+	BuyLimit(context.Context, *LimitOrderRequest) (*TransactionID, error)
+	SellLimit(context.Context, *LimitOrderRequest) (*TransactionID, error)
+	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+	GetOpenOrders(context.Context, *MarketRequest) (*GetOpenOrdersResponse, error)
+	// v2.0 market history and trade.  TradeBuy and TradeSell of the Go client are PlaceOrder calls.
+	GetTicks(context.Context, *GetTicksRequest) (*GetTicksResponse, error)
+	GetLatestTick(context.Context, *GetTicksRequest) (*Candle, error)
+	PlaceOrder(context.Context, *OrderRequest) (*PlacedOrder, error)
+	// socket subscriptions.  Every stream sees every delta; no markets means all markets.
+	SubscribeSummaries(*SubscribeRequest, grpc.ServerStreamingServer[MarketSummary]) error
+	SubscribeExchange(*SubscribeRequest, grpc.ServerStreamingServer[ExchangeDelta]) error
+	SubscribeOrders(*SubscribeOrdersRequest, grpc.ServerStreamingServer[OrderDelta]) error
+	SubscribeBalances(*SubscribeBalancesRequest, grpc.ServerStreamingServer[BalanceDelta]) error
+	mustEmbedUnimplementedBittrexServer()
+}
+
+// UnimplementedBittrexServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBittrexServer struct{}
+
+func (UnimplementedBittrexServer) GetMarkets(context.Context, *GetMarketsRequest) (*GetMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarkets not implemented")
+}
+func (UnimplementedBittrexServer) GetCurrencies(context.Context, *GetCurrenciesRequest) (*GetCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencies not implemented")
+}
+func (UnimplementedBittrexServer) GetTicker(context.Context, *MarketRequest) (*Ticker, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (UnimplementedBittrexServer) GetMarketSummaries(context.Context, *GetMarketSummariesRequest) (*GetMarketSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketSummaries not implemented")
+}
+func (UnimplementedBittrexServer) GetMarketSummary(context.Context, *MarketRequest) (*MarketSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketSummary not implemented")
+}
+func (UnimplementedBittrexServer) GetOrderBook(context.Context, *GetOrderBookRequest) (*OrderBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedBittrexServer) GetMarketHistory(context.Context, *MarketRequest) (*GetMarketHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketHistory not implemented")
+}
+func (UnimplementedBittrexServer) GetBalances(context.Context, *GetBalancesRequest) (*GetBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalances not implemented")
+}
+func (UnimplementedBittrexServer) GetBalance(context.Context, *CurrencyRequest) (*AccountBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedBittrexServer) GetDepositAddress(context.Context, *CurrencyRequest) (*WalletAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositAddress not implemented")
+}
+func (UnimplementedBittrexServer) Withdraw(context.Context, *WithdrawRequest) (*TransactionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedBittrexServer) GetOrder(context.Context, *GetOrderRequest) (*AccountOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedBittrexServer) GetOrderHistory(context.Context, *MarketRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedBittrexServer) GetWithdrawalHistory(context.Context, *CurrencyRequest) (*GetTransferHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalHistory not implemented")
+}
+func (UnimplementedBittrexServer) GetDepositHistory(context.Context, *CurrencyRequest) (*GetTransferHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositHistory not implemented")
+}
+func (UnimplementedBittrexServer) BuyLimit(context.Context, *LimitOrderRequest) (*TransactionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLimit not implemented")
+}
+func (UnimplementedBittrexServer) SellLimit(context.Context, *LimitOrderRequest) (*TransactionID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellLimit not implemented")
+}
+func (UnimplementedBittrexServer) Cancel(context.Context, *CancelRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedBittrexServer) GetOpenOrders(context.Context, *MarketRequest) (*GetOpenOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenOrders not implemented")
+}
+func (UnimplementedBittrexServer) GetTicks(context.Context, *GetTicksRequest) (*GetTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicks not implemented")
+}
+func (UnimplementedBittrexServer) GetLatestTick(context.Context, *GetTicksRequest) (*Candle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTick not implemented")
+}
+func (UnimplementedBittrexServer) PlaceOrder(context.Context, *OrderRequest) (*PlacedOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedBittrexServer) SubscribeSummaries(*SubscribeRequest, grpc.ServerStreamingServer[MarketSummary]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSummaries not implemented")
+}
+func (UnimplementedBittrexServer) SubscribeExchange(*SubscribeRequest, grpc.ServerStreamingServer[ExchangeDelta]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeExchange not implemented")
+}
+func (UnimplementedBittrexServer) SubscribeOrders(*SubscribeOrdersRequest, grpc.ServerStreamingServer[OrderDelta]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOrders not implemented")
+}
+func (UnimplementedBittrexServer) SubscribeBalances(*SubscribeBalancesRequest, grpc.ServerStreamingServer[BalanceDelta]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBalances not implemented")
+}
+func (UnimplementedBittrexServer) mustEmbedUnimplementedBittrexServer() {}
+func (UnimplementedBittrexServer) testEmbeddedByValue()                 {}
+
+// UnsafeBittrexServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BittrexServer will
+// result in compilation errors.
+type UnsafeBittrexServer interface {
+	mustEmbedUnimplementedBittrexServer()
+}
+
+func RegisterBittrexServer(s grpc.ServiceRegistrar, srv BittrexServer) {
+	// If the following call pancis, it indicates UnimplementedBittrexServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Bittrex_ServiceDesc, srv)
+}
+
+func _Bittrex_GetMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetMarkets(ctx, req.(*GetMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetCurrencies(ctx, req.(*GetCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetTicker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetTicker(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetMarketSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarketSummariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetMarketSummaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetMarketSummaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetMarketSummaries(ctx, req.(*GetMarketSummariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetMarketSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetMarketSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetMarketSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetMarketSummary(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetOrderBook(ctx, req.(*GetOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetMarketHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetMarketHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetMarketHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetMarketHistory(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetBalances(ctx, req.(*GetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetBalance(ctx, req.(*CurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetDepositAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetDepositAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetDepositAddress(ctx, req.(*CurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetOrderHistory(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetWithdrawalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetWithdrawalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetWithdrawalHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetWithdrawalHistory(ctx, req.(*CurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetDepositHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetDepositHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetDepositHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetDepositHistory(ctx, req.(*CurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_BuyLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).BuyLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_BuyLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).BuyLimit(ctx, req.(*LimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_SellLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).SellLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_SellLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).SellLimit(ctx, req.(*LimitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).Cancel(ctx, req.(*CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetOpenOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetOpenOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetOpenOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetOpenOrders(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetTicks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetTicks(ctx, req.(*GetTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_GetLatestTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).GetLatestTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_GetLatestTick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).GetLatestTick(ctx, req.(*GetTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BittrexServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bittrex_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BittrexServer).PlaceOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bittrex_SubscribeSummaries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BittrexServer).SubscribeSummaries(m, &grpc.GenericServerStream[SubscribeRequest, MarketSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeSummariesServer = grpc.ServerStreamingServer[MarketSummary]
+
+func _Bittrex_SubscribeExchange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BittrexServer).SubscribeExchange(m, &grpc.GenericServerStream[SubscribeRequest, ExchangeDelta]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeExchangeServer = grpc.ServerStreamingServer[ExchangeDelta]
+
+func _Bittrex_SubscribeOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BittrexServer).SubscribeOrders(m, &grpc.GenericServerStream[SubscribeOrdersRequest, OrderDelta]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeOrdersServer = grpc.ServerStreamingServer[OrderDelta]
+
+func _Bittrex_SubscribeBalances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBalancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BittrexServer).SubscribeBalances(m, &grpc.GenericServerStream[SubscribeBalancesRequest, BalanceDelta]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Bittrex_SubscribeBalancesServer = grpc.ServerStreamingServer[BalanceDelta]
+
+// Bittrex_ServiceDesc is the grpc.ServiceDesc for Bittrex service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bittrex_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bittrex.v1.Bittrex",
+	HandlerType: (*BittrexServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMarkets",
+			Handler:    _Bittrex_GetMarkets_Handler,
+		},
+		{
+			MethodName: "GetCurrencies",
+			Handler:    _Bittrex_GetCurrencies_Handler,
+		},
+		{
+			MethodName: "GetTicker",
+			Handler:    _Bittrex_GetTicker_Handler,
+		},
+		{
+			MethodName: "GetMarketSummaries",
+			Handler:    _Bittrex_GetMarketSummaries_Handler,
+		},
+		{
+			MethodName: "GetMarketSummary",
+			Handler:    _Bittrex_GetMarketSummary_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _Bittrex_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "GetMarketHistory",
+			Handler:    _Bittrex_GetMarketHistory_Handler,
+		},
+		{
+			MethodName: "GetBalances",
+			Handler:    _Bittrex_GetBalances_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Bittrex_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetDepositAddress",
+			Handler:    _Bittrex_GetDepositAddress_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _Bittrex_Withdraw_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Bittrex_GetOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _Bittrex_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalHistory",
+			Handler:    _Bittrex_GetWithdrawalHistory_Handler,
+		},
+		{
+			MethodName: "GetDepositHistory",
+			Handler:    _Bittrex_GetDepositHistory_Handler,
+		},
+		{
+			MethodName: "BuyLimit",
+			Handler:    _Bittrex_BuyLimit_Handler,
+		},
+		{
+			MethodName: "SellLimit",
+			Handler:    _Bittrex_SellLimit_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _Bittrex_Cancel_Handler,
+		},
+		{
+			MethodName: "GetOpenOrders",
+			Handler:    _Bittrex_GetOpenOrders_Handler,
+		},
+		{
+			MethodName: "GetTicks",
+			Handler:    _Bittrex_GetTicks_Handler,
+		},
+		{
+			MethodName: "GetLatestTick",
+			Handler:    _Bittrex_GetLatestTick_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Bittrex_PlaceOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeSummaries",
+			Handler:       _Bittrex_SubscribeSummaries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeExchange",
+			Handler:       _Bittrex_SubscribeExchange_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeOrders",
+			Handler:       _Bittrex_SubscribeOrders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBalances",
+			Handler:       _Bittrex_SubscribeBalances_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bittrex.proto",
+}
@@ -0,0 +1,10 @@
+/*
+Package bittrexpb the protobuf messages and gRPC service generated from bittrex.proto.  The generated files are
+committed, so building needs neither protoc nor the plugins.  After editing the proto, regenerate with go generate;
+protoc must be on the PATH, and the plugins are installed at the versions the committed files were generated with.
+*/
+package bittrexpb
+
+//go:generate go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.36.9
+//go:generate go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.5.1
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative bittrex.proto
@@ -0,0 +1,668 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/rpc/bittrexpb"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//defaultTimeout per call, matching the bittrex Client's REST timeout.
+const defaultTimeout = 30 * time.Second
+
+/*
+Client bittrex.API over a connection to a Server.  Refusals from the exchange come back as bittrex.APIError, as they
+would from a bittrex Client; other failures are plain errors.
+
+The Subscribe channels behave like the bittrex Client's: one channel per market, never closed while the stream is
+up, and unbuffered, so they must be drained.  A stream that ends closes its channel and reports why on Errors.
+*/
+type Client struct {
+	rpc     bittrexpb.BittrexClient
+	timeout time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	streamMutex sync.Mutex
+	summaries   map[string]chan socketPayloads.Summary
+	exchanges   map[string]chan socketPayloads.ExchangeDelta
+	orders      chan socketPayloads.OrderResponse
+	balances    chan socketPayloads.BalanceDelta
+
+	errChan chan error
+}
+
+var _ bittrex.API = (*Client)(nil)
+
+//NewClient call the server on conn, usually a *grpc.ClientConn.  The connection stays the caller's to close.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Client{
+		rpc:       bittrexpb.NewBittrexClient(conn),
+		timeout:   defaultTimeout,
+		ctx:       ctx,
+		cancel:    cancel,
+		summaries: make(map[string]chan socketPayloads.Summary),
+		exchanges: make(map[string]chan socketPayloads.ExchangeDelta),
+		errChan:   make(chan error, 5),
+	}
+}
+
+//SetTimeout limit on each unary call.  Streams are not affected.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.timeout = timeout
+}
+
+//Errors why streams ended.  Buffered; errors are dropped if it fills.
+func (c *Client) Errors() chan error {
+	return c.errChan
+}
+
+//Close end every stream.  Their channels are closed; nothing is sent on Errors.
+func (c *Client) Close() {
+	c.cancel()
+}
+
+func (c *Client) callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(c.ctx, c.timeout)
+}
+
+//fromStatus undo toStatus as far as it can.
+func fromStatus(method string, err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("rpc - %s: %s", method, err.Error())
+	}
+
+	switch st.Code() {
+	case codes.FailedPrecondition:
+		return bittrex.APIError{Endpoint: method, Message: st.Message()}
+	case codes.DataLoss:
+		return bittrex.OrderAcceptedError{Endpoint: method, Reason: st.Message()}
+	}
+
+	return fmt.Errorf("rpc - %s: %s", method, st.Message())
+}
+
+//PublicGetMarkets implement bittrex.API
+func (c *Client) PublicGetMarkets() ([]bittrex.MarketDescription, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetMarkets(ctx, &bittrexpb.GetMarketsRequest{})
+	if callErr != nil {
+		return nil, fromStatus("GetMarkets", callErr)
+	}
+
+	markets := make([]bittrex.MarketDescription, 0, len(response.Markets))
+	for _, market := range response.Markets {
+		markets = append(markets, marketFromPB(market))
+	}
+
+	return markets, nil
+}
+
+//PublicGetCurrencies implement bittrex.API
+func (c *Client) PublicGetCurrencies() ([]bittrex.Currency, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetCurrencies(ctx, &bittrexpb.GetCurrenciesRequest{})
+	if callErr != nil {
+		return nil, fromStatus("GetCurrencies", callErr)
+	}
+
+	currencies := make([]bittrex.Currency, 0, len(response.Currencies))
+	for _, currency := range response.Currencies {
+		currencies = append(currencies, currencyFromPB(currency))
+	}
+
+	return currencies, nil
+}
+
+//PublicGetTicker implement bittrex.API
+func (c *Client) PublicGetTicker(market string) (bittrex.Ticker, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetTicker(ctx, &bittrexpb.MarketRequest{Market: market})
+	if callErr != nil {
+		return bittrex.Ticker{}, fromStatus("GetTicker", callErr)
+	}
+
+	return bittrex.Ticker{Bid: response.Bid, Ask: response.Ask, Last: response.Last}, nil
+}
+
+//PublicGetMarketSummaries implement bittrex.API
+func (c *Client) PublicGetMarketSummaries() ([]bittrex.MarketSummary, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetMarketSummaries(ctx, &bittrexpb.GetMarketSummariesRequest{})
+	if callErr != nil {
+		return nil, fromStatus("GetMarketSummaries", callErr)
+	}
+
+	summaries := make([]bittrex.MarketSummary, 0, len(response.Summaries))
+	for _, summary := range response.Summaries {
+		summaries = append(summaries, marketSummaryFromPB(summary))
+	}
+
+	return summaries, nil
+}
+
+//PublicGetMarketSummary implement bittrex.API
+func (c *Client) PublicGetMarketSummary(market string) (bittrex.MarketSummary, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetMarketSummary(ctx, &bittrexpb.MarketRequest{Market: market})
+	if callErr != nil {
+		return bittrex.MarketSummary{}, fromStatus("GetMarketSummary", callErr)
+	}
+
+	return marketSummaryFromPB(response), nil
+}
+
+//PublicGetOrderBook implement bittrex.API
+func (c *Client) PublicGetOrderBook(market string, orderType string) (bittrex.OrderBook, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetOrderBook(ctx, &bittrexpb.GetOrderBookRequest{Market: market, Type: orderType})
+	if callErr != nil {
+		return bittrex.OrderBook{}, fromStatus("GetOrderBook", callErr)
+	}
+
+	return bittrex.OrderBook{Buy: orderBookSideFromPB(response.Buy), Sell: orderBookSideFromPB(response.Sell)}, nil
+}
+
+//PublicGetMarketHistory implement bittrex.API
+func (c *Client) PublicGetMarketHistory(market string) ([]bittrex.Trade, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetMarketHistory(ctx, &bittrexpb.MarketRequest{Market: market})
+	if callErr != nil {
+		return nil, fromStatus("GetMarketHistory", callErr)
+	}
+
+	trades := make([]bittrex.Trade, 0, len(response.Trades))
+	for _, trade := range response.Trades {
+		trades = append(trades, tradeFromPB(trade))
+	}
+
+	return trades, nil
+}
+
+//AccountGetBalances implement bittrex.API
+func (c *Client) AccountGetBalances() ([]bittrex.AccountBalance, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetBalances(ctx, &bittrexpb.GetBalancesRequest{})
+	if callErr != nil {
+		return nil, fromStatus("GetBalances", callErr)
+	}
+
+	balances := make([]bittrex.AccountBalance, 0, len(response.Balances))
+	for _, balance := range response.Balances {
+		balances = append(balances, balanceFromPB(balance))
+	}
+
+	return balances, nil
+}
+
+//AccountGetBalance implement bittrex.API
+func (c *Client) AccountGetBalance(currency string) (bittrex.AccountBalance, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetBalance(ctx, &bittrexpb.CurrencyRequest{Currency: currency})
+	if callErr != nil {
+		return bittrex.AccountBalance{}, fromStatus("GetBalance", callErr)
+	}
+
+	return balanceFromPB(response), nil
+}
+
+//AccountGetDepositAddress implement bittrex.API
+func (c *Client) AccountGetDepositAddress(currency string) (bittrex.WalletAddress, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetDepositAddress(ctx, &bittrexpb.CurrencyRequest{Currency: currency})
+	if callErr != nil {
+		return bittrex.WalletAddress{}, fromStatus("GetDepositAddress", callErr)
+	}
+
+	return bittrex.WalletAddress{Currency: response.Currency, Address: response.Address}, nil
+}
+
+//AccountWithdraw implement bittrex.API
+func (c *Client) AccountWithdraw(currency string, quantity float64, address string, paymentID string) (bittrex.TransactionID, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.Withdraw(ctx, &bittrexpb.WithdrawRequest{
+		Currency:  currency,
+		Quantity:  quantity,
+		Address:   address,
+		PaymentId: paymentID,
+	})
+	if callErr != nil {
+		return bittrex.TransactionID{}, fromStatus("Withdraw", callErr)
+	}
+
+	return bittrex.TransactionID{UUID: response.Uuid}, nil
+}
+
+//AccountGetOrder implement bittrex.API
+func (c *Client) AccountGetOrder(orderID string) (bittrex.AccountOrderDescription, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetOrder(ctx, &bittrexpb.GetOrderRequest{OrderUuid: orderID})
+	if callErr != nil {
+		return bittrex.AccountOrderDescription{}, fromStatus("GetOrder", callErr)
+	}
+
+	return accountOrderFromPB(response), nil
+}
+
+//AccountGetOrderHistory implement bittrex.API
+func (c *Client) AccountGetOrderHistory(market string) ([]bittrex.AccountOrderHistoryDescription, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetOrderHistory(ctx, &bittrexpb.MarketRequest{Market: market})
+	if callErr != nil {
+		return nil, fromStatus("GetOrderHistory", callErr)
+	}
+
+	orders := make([]bittrex.AccountOrderHistoryDescription, 0, len(response.Orders))
+	for _, order := range response.Orders {
+		orders = append(orders, orderHistoryFromPB(order))
+	}
+
+	return orders, nil
+}
+
+//AccountGetWithdrawalHistory implement bittrex.API
+func (c *Client) AccountGetWithdrawalHistory(currency string) ([]bittrex.TransactionHistoryDescription, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetWithdrawalHistory(ctx, &bittrexpb.CurrencyRequest{Currency: currency})
+	if callErr != nil {
+		return nil, fromStatus("GetWithdrawalHistory", callErr)
+	}
+
+	return transfersFromPB(response), nil
+}
+
+//AccountGetDepositHistory implement bittrex.API
+func (c *Client) AccountGetDepositHistory(currency string) ([]bittrex.TransactionHistoryDescription, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetDepositHistory(ctx, &bittrexpb.CurrencyRequest{Currency: currency})
+	if callErr != nil {
+		return nil, fromStatus("GetDepositHistory", callErr)
+	}
+
+	return transfersFromPB(response), nil
+}
+
+//MarketBuyLimit implement bittrex.API
+func (c *Client) MarketBuyLimit(market string, quantity float64, rate float64) (bittrex.TransactionID, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.BuyLimit(ctx, &bittrexpb.LimitOrderRequest{Market: market, Quantity: quantity, Rate: rate})
+	if callErr != nil {
+		return bittrex.TransactionID{}, fromStatus("BuyLimit", callErr)
+	}
+
+	return bittrex.TransactionID{UUID: response.Uuid}, nil
+}
+
+//MarketSellLimit implement bittrex.API
+func (c *Client) MarketSellLimit(market string, quantity float64, rate float64) (bittrex.TransactionID, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.SellLimit(ctx, &bittrexpb.LimitOrderRequest{Market: market, Quantity: quantity, Rate: rate})
+	if callErr != nil {
+		return bittrex.TransactionID{}, fromStatus("SellLimit", callErr)
+	}
+
+	return bittrex.TransactionID{UUID: response.Uuid}, nil
+}
+
+//MarketCancel implement bittrex.API
+func (c *Client) MarketCancel(uuid string) (bool, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.Cancel(ctx, &bittrexpb.CancelRequest{Uuid: uuid})
+	if callErr != nil {
+		return false, fromStatus("Cancel", callErr)
+	}
+
+	return response.Cancelled, nil
+}
+
+//MarketGetOpenOrders implement bittrex.API
+func (c *Client) MarketGetOpenOrders(market string) ([]bittrex.OrderDescription, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetOpenOrders(ctx, &bittrexpb.MarketRequest{Market: market})
+	if callErr != nil {
+		return nil, fromStatus("GetOpenOrders", callErr)
+	}
+
+	orders := make([]bittrex.OrderDescription, 0, len(response.Orders))
+	for _, order := range response.Orders {
+		orders = append(orders, openOrderFromPB(order))
+	}
+
+	return orders, nil
+}
+
+//PubMarketGetTicks implement bittrex.API
+func (c *Client) PubMarketGetTicks(market string, interval string) ([]bittrex.Candle, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetTicks(ctx, &bittrexpb.GetTicksRequest{Market: market, Interval: interval})
+	if callErr != nil {
+		return nil, fromStatus("GetTicks", callErr)
+	}
+
+	candles := make([]bittrex.Candle, 0, len(response.Candles))
+	for _, candle := range response.Candles {
+		candles = append(candles, candleFromPB(candle))
+	}
+
+	return candles, nil
+}
+
+//PubMarketGetLatestTick implement bittrex.API
+func (c *Client) PubMarketGetLatestTick(market string, interval string) (bittrex.Candle, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.GetLatestTick(ctx, &bittrexpb.GetTicksRequest{Market: market, Interval: interval})
+	if callErr != nil {
+		return bittrex.Candle{}, fromStatus("GetLatestTick", callErr)
+	}
+
+	return candleFromPB(response), nil
+}
+
+//PlaceOrder implement bittrex.API.  Validation, normalization and risk checks happen on the server's client.
+func (c *Client) PlaceOrder(request bittrex.OrderRequest) (bittrex.PlacedOrder, error) {
+	ctx, cancel := c.callContext()
+	defer cancel()
+
+	response, callErr := c.rpc.PlaceOrder(ctx, orderRequestToPB(request))
+	if callErr != nil {
+		return bittrex.PlacedOrder{}, fromStatus("PlaceOrder", callErr)
+	}
+
+	return placedOrderFromPB(response), nil
+}
+
+//KeyMarketTradeBuy implement bittrex.API, as a PlaceOrder call.
+func (c *Client) KeyMarketTradeBuy(
+	market string,
+	quantity float64,
+	rate float64,
+	timeInEffect string,
+	conditionType string,
+	conditionTarget float64,
+) (bool, error) {
+	return c.trade(bittrex.OrderSideBuy, market, quantity, rate, timeInEffect, conditionType, conditionTarget)
+}
+
+//KeyMarketTradeSell implement bittrex.API, as a PlaceOrder call.
+func (c *Client) KeyMarketTradeSell(
+	market string,
+	quantity float64,
+	rate float64,
+	timeInEffect string,
+	conditionType string,
+	conditionTarget float64,
+) (bool, error) {
+	return c.trade(bittrex.OrderSideSell, market, quantity, rate, timeInEffect, conditionType, conditionTarget)
+}
+
+func (c *Client) trade(
+	side bittrex.OrderSide,
+	market string,
+	quantity float64,
+	rate float64,
+	timeInEffect string,
+	conditionType string,
+	conditionTarget float64,
+) (bool, error) {
+	_, placeErr := c.PlaceOrder(bittrex.OrderRequest{
+		Market:          market,
+		Side:            side,
+		Type:            bittrex.OrderTypeLimit,
+		Quantity:        quantity,
+		Rate:            rate,
+		TimeInForce:     bittrex.TimeInForce(timeInEffect),
+		Condition:       bittrex.OrderCondition(conditionType),
+		ConditionTarget: conditionTarget,
+	})
+
+	//an order accepted with an unreadable response is live, as with a bittrex Client.
+	_, accepted := placeErr.(bittrex.OrderAcceptedError)
+
+	return placeErr == nil || accepted, placeErr
+}
+
+//streamEnded report why a stream ended, unless it was Close.
+func (c *Client) streamEnded(method string, recvErr error) {
+	if c.ctx.Err() != nil {
+		return
+	}
+
+	if recvErr == io.EOF {
+		recvErr = fmt.Errorf("rpc - %s: server ended the stream", method)
+	} else {
+		recvErr = fromStatus(method, recvErr)
+	}
+
+	select {
+	case c.errChan <- recvErr:
+	default:
+	}
+}
+
+//SubscribeToMarketSummary implement bittrex.API
+func (c *Client) SubscribeToMarketSummary(market string) (chan socketPayloads.Summary, error) {
+	market = strings.ToUpper(market)
+
+	c.streamMutex.Lock()
+	defer c.streamMutex.Unlock()
+
+	if ch, ok := c.summaries[market]; ok {
+		return ch, nil
+	}
+
+	stream, openErr := c.rpc.SubscribeSummaries(c.ctx, &bittrexpb.SubscribeRequest{Markets: []string{market}})
+	if openErr != nil {
+		return nil, fromStatus("SubscribeSummaries", openErr)
+	}
+
+	ch := make(chan socketPayloads.Summary)
+	c.summaries[market] = ch
+
+	go func() {
+		defer close(ch)
+		defer c.forget(func() { delete(c.summaries, market) })
+
+		for {
+			delta, recvErr := stream.Recv()
+			if recvErr != nil {
+				c.streamEnded("SubscribeSummaries", recvErr)
+				return
+			}
+
+			select {
+			case ch <- summaryDeltaFromPB(delta):
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+//SubscribeToExchange implement bittrex.API
+func (c *Client) SubscribeToExchange(market string) (chan socketPayloads.ExchangeDelta, error) {
+	market = strings.ToUpper(market)
+
+	c.streamMutex.Lock()
+	defer c.streamMutex.Unlock()
+
+	if ch, ok := c.exchanges[market]; ok {
+		return ch, nil
+	}
+
+	stream, openErr := c.rpc.SubscribeExchange(c.ctx, &bittrexpb.SubscribeRequest{Markets: []string{market}})
+	if openErr != nil {
+		return nil, fromStatus("SubscribeExchange", openErr)
+	}
+
+	ch := make(chan socketPayloads.ExchangeDelta)
+	c.exchanges[market] = ch
+
+	go func() {
+		defer close(ch)
+		defer c.forget(func() { delete(c.exchanges, market) })
+
+		for {
+			delta, recvErr := stream.Recv()
+			if recvErr != nil {
+				c.streamEnded("SubscribeExchange", recvErr)
+				return
+			}
+
+			select {
+			case ch <- exchangeDeltaFromPB(delta):
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+/*
+SubscribeToOrderChanges implement bittrex.API.  bittrex.API gives this no error to return, so a stream that
+can't be opened is reported on Errors, and the channel is closed.
+*/
+func (c *Client) SubscribeToOrderChanges() chan socketPayloads.OrderResponse {
+	c.streamMutex.Lock()
+	defer c.streamMutex.Unlock()
+
+	if c.orders != nil {
+		return c.orders
+	}
+
+	ch := make(chan socketPayloads.OrderResponse)
+	c.orders = ch
+
+	go func() {
+		defer close(ch)
+		defer c.forget(func() { c.orders = nil })
+
+		stream, openErr := c.rpc.SubscribeOrders(c.ctx, &bittrexpb.SubscribeOrdersRequest{})
+		if openErr != nil {
+			c.streamEnded("SubscribeOrders", openErr)
+			return
+		}
+
+		for {
+			delta, recvErr := stream.Recv()
+			if recvErr != nil {
+				c.streamEnded("SubscribeOrders", recvErr)
+				return
+			}
+
+			select {
+			case ch <- orderDeltaFromPB(delta):
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+//SubscribeToBalanceChanges implement bittrex.API.  Failures are reported as for SubscribeToOrderChanges.
+func (c *Client) SubscribeToBalanceChanges() chan socketPayloads.BalanceDelta {
+	c.streamMutex.Lock()
+	defer c.streamMutex.Unlock()
+
+	if c.balances != nil {
+		return c.balances
+	}
+
+	ch := make(chan socketPayloads.BalanceDelta)
+	c.balances = ch
+
+	go func() {
+		defer close(ch)
+		defer c.forget(func() { c.balances = nil })
+
+		stream, openErr := c.rpc.SubscribeBalances(c.ctx, &bittrexpb.SubscribeBalancesRequest{})
+		if openErr != nil {
+			c.streamEnded("SubscribeBalances", openErr)
+			return
+		}
+
+		for {
+			delta, recvErr := stream.Recv()
+			if recvErr != nil {
+				c.streamEnded("SubscribeBalances", recvErr)
+				return
+			}
+
+			select {
+			case ch <- balanceDeltaFromPB(delta):
+			case <-c.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+//forget drop an ended stream's channel, so the next Subscribe call opens a new stream.
+func (c *Client) forget(remove func()) {
+	c.streamMutex.Lock()
+	remove()
+	c.streamMutex.Unlock()
+}
@@ -0,0 +1,680 @@
+package rpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/rpc/bittrexpb"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//the zero time travels as an unset timestamp, so null dates such as Closed on an open order survive the round trip.
+func timeToPB(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func timeFromPB(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func timestampToPB(t bittrex.Timestamp) *timestamppb.Timestamp {
+	return timeToPB(time.Time(t))
+}
+
+func timestampFromPB(ts *timestamppb.Timestamp) bittrex.Timestamp {
+	return bittrex.Timestamp(timeFromPB(ts))
+}
+
+func marketToPB(m bittrex.MarketDescription) *bittrexpb.Market {
+	return &bittrexpb.Market{
+		MarketCurrency:     m.MarketCurrency,
+		BaseCurrency:       m.BaseCurrency,
+		MarketCurrencyLong: m.MarketCurrencyLong,
+		BaseCurrencyLong:   m.BaseCurrencyLong,
+		MinTradeSize:       m.MinTradeSize,
+		MarketName:         m.MarketName,
+		IsActive:           m.IsActive,
+		Created:            timestampToPB(m.Created),
+	}
+}
+
+func marketFromPB(m *bittrexpb.Market) bittrex.MarketDescription {
+	return bittrex.MarketDescription{
+		MarketCurrency:     m.MarketCurrency,
+		BaseCurrency:       m.BaseCurrency,
+		MarketCurrencyLong: m.MarketCurrencyLong,
+		BaseCurrencyLong:   m.BaseCurrencyLong,
+		MinTradeSize:       m.MinTradeSize,
+		MarketName:         m.MarketName,
+		IsActive:           m.IsActive,
+		Created:            timestampFromPB(m.Created),
+	}
+}
+
+func currencyToPB(c bittrex.Currency) *bittrexpb.Currency {
+	return &bittrexpb.Currency{
+		Currency:        c.Currency,
+		CurrencyLong:    c.CurrencyLong,
+		MinConfirmation: int32(c.MinConfirmation),
+		TxFee:           c.TxFee,
+		IsActive:        c.IsActive,
+		CoinType:        c.CoinType,
+		BaseAddress:     c.BaseAddress,
+	}
+}
+
+func currencyFromPB(c *bittrexpb.Currency) bittrex.Currency {
+	return bittrex.Currency{
+		Currency:        c.Currency,
+		CurrencyLong:    c.CurrencyLong,
+		MinConfirmation: int(c.MinConfirmation),
+		TxFee:           c.TxFee,
+		IsActive:        c.IsActive,
+		CoinType:        c.CoinType,
+		BaseAddress:     c.BaseAddress,
+	}
+}
+
+func marketSummaryToPB(s bittrex.MarketSummary) *bittrexpb.MarketSummary {
+	return &bittrexpb.MarketSummary{
+		MarketName:        s.MarketName,
+		High:              s.High,
+		Low:               s.Low,
+		Volume:            s.Volume,
+		Last:              s.Last,
+		BaseVolume:        s.BaseVolume,
+		TimeStamp:         timestampToPB(s.TimeStamp),
+		Bid:               s.Bid,
+		Ask:               s.Ask,
+		OpenBuyOrders:     int32(s.OpenBuyOrders),
+		OpenSellOrders:    int32(s.OpenSellOrders),
+		PrevDay:           s.PrevDay,
+		Created:           timestampToPB(s.Created),
+		DisplayMarketName: s.DisplayMarketName,
+	}
+}
+
+func marketSummaryFromPB(s *bittrexpb.MarketSummary) bittrex.MarketSummary {
+	return bittrex.MarketSummary{
+		MarketName:        s.MarketName,
+		High:              s.High,
+		Low:               s.Low,
+		Volume:            s.Volume,
+		Last:              s.Last,
+		BaseVolume:        s.BaseVolume,
+		TimeStamp:         timestampFromPB(s.TimeStamp),
+		Bid:               s.Bid,
+		Ask:               s.Ask,
+		OpenBuyOrders:     int(s.OpenBuyOrders),
+		OpenSellOrders:    int(s.OpenSellOrders),
+		PrevDay:           s.PrevDay,
+		Created:           timestampFromPB(s.Created),
+		DisplayMarketName: s.DisplayMarketName,
+	}
+}
+
+func orderBookSideToPB(side []bittrex.OrderElement) []*bittrexpb.OrderBookEntry {
+	entries := make([]*bittrexpb.OrderBookEntry, 0, len(side))
+	for _, element := range side {
+		entries = append(entries, &bittrexpb.OrderBookEntry{Quantity: element.Quantity, Rate: element.Rate})
+	}
+
+	return entries
+}
+
+func orderBookSideFromPB(entries []*bittrexpb.OrderBookEntry) []bittrex.OrderElement {
+	side := make([]bittrex.OrderElement, 0, len(entries))
+	for _, entry := range entries {
+		side = append(side, bittrex.OrderElement{Quantity: entry.Quantity, Rate: entry.Rate})
+	}
+
+	return side
+}
+
+func tradeToPB(t bittrex.Trade) *bittrexpb.Trade {
+	return &bittrexpb.Trade{
+		Id:        t.ID,
+		TimeStamp: timestampToPB(t.TimeStamp),
+		Quantity:  t.Quantity,
+		Price:     t.Price,
+		Total:     t.Total,
+		FillType:  t.FillType,
+		OrderType: t.OrderType,
+	}
+}
+
+func tradeFromPB(t *bittrexpb.Trade) bittrex.Trade {
+	return bittrex.Trade{
+		ID:        t.Id,
+		TimeStamp: timestampFromPB(t.TimeStamp),
+		Quantity:  t.Quantity,
+		Price:     t.Price,
+		Total:     t.Total,
+		FillType:  t.FillType,
+		OrderType: t.OrderType,
+	}
+}
+
+func balanceToPB(b bittrex.AccountBalance) *bittrexpb.AccountBalance {
+	return &bittrexpb.AccountBalance{
+		Currency:      b.Currency,
+		Balance:       b.Balance,
+		Available:     b.Available,
+		Pending:       b.Pending,
+		CryptoAddress: b.CryptoAddress,
+		Requested:     b.Requested,
+		Uuid:          b.UUID,
+	}
+}
+
+func balanceFromPB(b *bittrexpb.AccountBalance) bittrex.AccountBalance {
+	return bittrex.AccountBalance{
+		Currency:      b.Currency,
+		Balance:       b.Balance,
+		Available:     b.Available,
+		Pending:       b.Pending,
+		CryptoAddress: b.CryptoAddress,
+		Requested:     b.Requested,
+		UUID:          b.Uuid,
+	}
+}
+
+func accountOrderToPB(o bittrex.AccountOrderDescription) *bittrexpb.AccountOrder {
+	return &bittrexpb.AccountOrder{
+		AccountId:                  o.AccountID,
+		OrderUuid:                  o.OrderUUID,
+		Exchange:                   o.Exchange,
+		Type:                       o.Type,
+		Quantity:                   o.Quantity,
+		QuantityRemaining:          o.QuantityRemaining,
+		Limit:                      o.Limit,
+		Reserved:                   o.Reserved,
+		ReserveRemaining:           o.ReserveRemaining,
+		CommissionReserved:         o.CommissionReserved,
+		CommissionReserveRemaining: o.CommissionReserveRemaining,
+		CommissionPaid:             o.CommissionPaid,
+		Price:                      o.Price,
+		PricePerUnit:               o.PricePerUnit,
+		Opened:                     timestampToPB(o.Opened),
+		Closed:                     timestampToPB(o.Closed),
+		IsOpen:                     o.IsOpen,
+		Sentinel:                   o.Sentinel,
+		CancelInitiated:            o.CancelInitiated,
+		ImmediateOrCancel:          o.ImmediateOrCancel,
+		IsConditional:              o.IsConditional,
+		Condition:                  o.Condition,
+		ConditionTarget:            o.ConditionTarget,
+	}
+}
+
+func accountOrderFromPB(o *bittrexpb.AccountOrder) bittrex.AccountOrderDescription {
+	return bittrex.AccountOrderDescription{
+		AccountID:                  o.AccountId,
+		OrderUUID:                  o.OrderUuid,
+		Exchange:                   o.Exchange,
+		Type:                       o.Type,
+		Quantity:                   o.Quantity,
+		QuantityRemaining:          o.QuantityRemaining,
+		Limit:                      o.Limit,
+		Reserved:                   o.Reserved,
+		ReserveRemaining:           o.ReserveRemaining,
+		CommissionReserved:         o.CommissionReserved,
+		CommissionReserveRemaining: o.CommissionReserveRemaining,
+		CommissionPaid:             o.CommissionPaid,
+		Price:                      o.Price,
+		PricePerUnit:               o.PricePerUnit,
+		Opened:                     timestampFromPB(o.Opened),
+		Closed:                     timestampFromPB(o.Closed),
+		IsOpen:                     o.IsOpen,
+		Sentinel:                   o.Sentinel,
+		CancelInitiated:            o.CancelInitiated,
+		ImmediateOrCancel:          o.ImmediateOrCancel,
+		IsConditional:              o.IsConditional,
+		Condition:                  o.Condition,
+		ConditionTarget:            o.ConditionTarget,
+	}
+}
+
+func orderHistoryToPB(o bittrex.AccountOrderHistoryDescription) *bittrexpb.OrderHistoryEntry {
+	return &bittrexpb.OrderHistoryEntry{
+		OrderUuid:         o.OrderUUID,
+		Exchange:          o.Exchange,
+		TimeStamp:         timestampToPB(o.TimeStamp),
+		OrderType:         o.OrderType,
+		Limit:             o.Limit,
+		Quantity:          o.Quantity,
+		QuantityRemaining: o.QuantityRemaining,
+		Commission:        o.Commission,
+		Price:             o.Price,
+		PricePerUnit:      o.PricePerUnit,
+		IsConditional:     o.IsConditional,
+		Condition:         o.Condition,
+		ConditionTarget:   o.ConditionTarget,
+		ImmediateOrCancel: o.ImmediateOrCancel,
+	}
+}
+
+func orderHistoryFromPB(o *bittrexpb.OrderHistoryEntry) bittrex.AccountOrderHistoryDescription {
+	return bittrex.AccountOrderHistoryDescription{
+		OrderUUID:         o.OrderUuid,
+		Exchange:          o.Exchange,
+		TimeStamp:         timestampFromPB(o.TimeStamp),
+		OrderType:         o.OrderType,
+		Limit:             o.Limit,
+		Quantity:          o.Quantity,
+		QuantityRemaining: o.QuantityRemaining,
+		Commission:        o.Commission,
+		Price:             o.Price,
+		PricePerUnit:      o.PricePerUnit,
+		IsConditional:     o.IsConditional,
+		Condition:         o.Condition,
+		ConditionTarget:   o.ConditionTarget,
+		ImmediateOrCancel: o.ImmediateOrCancel,
+	}
+}
+
+func transferToPB(t bittrex.TransactionHistoryDescription) *bittrexpb.Transfer {
+	return &bittrexpb.Transfer{
+		PaymentUuid:    t.PaymentUUID,
+		Currency:       t.Currency,
+		Amount:         t.Amount,
+		Address:        t.Address,
+		Opened:         timestampToPB(t.Opened),
+		Authorized:     t.Authorized,
+		PendingPayment: t.PendingPayment,
+		TxCost:         t.TxCost,
+		TxId:           t.TxID,
+		Canceled:       t.Canceled,
+		InvalidAddress: t.InvalidAddress,
+		Id:             int64(t.ID),
+		Confirmations:  int32(t.Confirmations),
+		LastUpdated:    timestampToPB(t.LastUpdated),
+		CryptoAddress:  t.CryptoAddress,
+	}
+}
+
+func transferFromPB(t *bittrexpb.Transfer) bittrex.TransactionHistoryDescription {
+	return bittrex.TransactionHistoryDescription{
+		PaymentUUID:    t.PaymentUuid,
+		Currency:       t.Currency,
+		Amount:         t.Amount,
+		Address:        t.Address,
+		Opened:         timestampFromPB(t.Opened),
+		Authorized:     t.Authorized,
+		PendingPayment: t.PendingPayment,
+		TxCost:         t.TxCost,
+		TxID:           t.TxId,
+		Canceled:       t.Canceled,
+		InvalidAddress: t.InvalidAddress,
+		ID:             int(t.Id),
+		Confirmations:  int(t.Confirmations),
+		LastUpdated:    timestampFromPB(t.LastUpdated),
+		CryptoAddress:  t.CryptoAddress,
+	}
+}
+
+func transfersToPB(transfers []bittrex.TransactionHistoryDescription) *bittrexpb.GetTransferHistoryResponse {
+	response := &bittrexpb.GetTransferHistoryResponse{}
+	for _, transfer := range transfers {
+		response.Transfers = append(response.Transfers, transferToPB(transfer))
+	}
+
+	return response
+}
+
+func transfersFromPB(response *bittrexpb.GetTransferHistoryResponse) []bittrex.TransactionHistoryDescription {
+	transfers := make([]bittrex.TransactionHistoryDescription, 0, len(response.Transfers))
+	for _, transfer := range response.Transfers {
+		transfers = append(transfers, transferFromPB(transfer))
+	}
+
+	return transfers
+}
+
+func openOrderToPB(o bittrex.OrderDescription) *bittrexpb.OpenOrder {
+	return &bittrexpb.OpenOrder{
+		Uuid:              o.UUID,
+		OrderUuid:         o.OrderUUID,
+		Exchange:          o.Exchange,
+		OrderType:         o.OrderType,
+		Quantity:          o.Quantity,
+		QuantityRemaining: o.QuantityRemaining,
+		Limit:             o.Limit,
+		CommissionPaid:    o.CommissionPaid,
+		Price:             o.Price,
+		PricePerUnit:      o.PricePerUnit,
+		Opened:            timestampToPB(o.Opened),
+		Closed:            timestampToPB(o.Closed),
+		CancelInitiated:   o.CancelInitiated,
+		ImmediateOrCancel: o.ImmediateOrCancel,
+		IsConditional:     o.IsConditional,
+		Condition:         o.Condition,
+		ConditionTarget:   o.ConditionTarget,
+	}
+}
+
+func openOrderFromPB(o *bittrexpb.OpenOrder) bittrex.OrderDescription {
+	return bittrex.OrderDescription{
+		UUID:              o.Uuid,
+		OrderUUID:         o.OrderUuid,
+		Exchange:          o.Exchange,
+		OrderType:         o.OrderType,
+		Quantity:          o.Quantity,
+		QuantityRemaining: o.QuantityRemaining,
+		Limit:             o.Limit,
+		CommissionPaid:    o.CommissionPaid,
+		Price:             o.Price,
+		PricePerUnit:      o.PricePerUnit,
+		Opened:            timestampFromPB(o.Opened),
+		Closed:            timestampFromPB(o.Closed),
+		CancelInitiated:   o.CancelInitiated,
+		ImmediateOrCancel: o.ImmediateOrCancel,
+		IsConditional:     o.IsConditional,
+		Condition:         o.Condition,
+		ConditionTarget:   o.ConditionTarget,
+	}
+}
+
+func candleToPB(c bittrex.Candle) *bittrexpb.Candle {
+	return &bittrexpb.Candle{
+		TimeStamp:  timestampToPB(c.TimeStamp),
+		Open:       c.Open,
+		Close:      c.Close,
+		High:       c.High,
+		Low:        c.Low,
+		Volume:     c.Volume,
+		BaseVolume: c.BaseVolume,
+	}
+}
+
+func candleFromPB(c *bittrexpb.Candle) bittrex.Candle {
+	return bittrex.Candle{
+		TimeStamp:  timestampFromPB(c.TimeStamp),
+		Open:       c.Open,
+		Close:      c.Close,
+		High:       c.High,
+		Low:        c.Low,
+		Volume:     c.Volume,
+		BaseVolume: c.BaseVolume,
+	}
+}
+
+func orderRequestToPB(r bittrex.OrderRequest) *bittrexpb.OrderRequest {
+	return &bittrexpb.OrderRequest{
+		Market:          r.Market,
+		Side:            string(r.Side),
+		Type:            string(r.Type),
+		Quantity:        r.Quantity,
+		Rate:            r.Rate,
+		TimeInForce:     string(r.TimeInForce),
+		Condition:       string(r.Condition),
+		ConditionTarget: r.ConditionTarget,
+	}
+}
+
+func orderRequestFromPB(r *bittrexpb.OrderRequest) bittrex.OrderRequest {
+	return bittrex.OrderRequest{
+		Market:          r.Market,
+		Side:            bittrex.OrderSide(r.Side),
+		Type:            bittrex.OrderType(r.Type),
+		Quantity:        r.Quantity,
+		Rate:            r.Rate,
+		TimeInForce:     bittrex.TimeInForce(r.TimeInForce),
+		Condition:       bittrex.OrderCondition(r.Condition),
+		ConditionTarget: r.ConditionTarget,
+	}
+}
+
+func placedOrderToPB(o bittrex.PlacedOrder) *bittrexpb.PlacedOrder {
+	return &bittrexpb.PlacedOrder{
+		OrderId:        o.OrderID,
+		MarketName:     o.MarketName,
+		MarketCurrency: o.MarketCurrency,
+		BuyOrSell:      o.BuyOrSell,
+		OrderType:      o.OrderType,
+		Quantity:       o.Quantity,
+		Rate:           o.Rate,
+	}
+}
+
+func placedOrderFromPB(o *bittrexpb.PlacedOrder) bittrex.PlacedOrder {
+	return bittrex.PlacedOrder{
+		OrderID:        o.OrderId,
+		MarketName:     o.MarketName,
+		MarketCurrency: o.MarketCurrency,
+		BuyOrSell:      o.BuyOrSell,
+		OrderType:      o.OrderType,
+		Quantity:       o.Quantity,
+		Rate:           o.Rate,
+	}
+}
+
+func summaryDeltaToPB(s socketPayloads.Summary) *bittrexpb.MarketSummary {
+	return &bittrexpb.MarketSummary{
+		MarketName:     s.MarketName,
+		High:           s.High,
+		Low:            s.Low,
+		Volume:         s.Volume,
+		Last:           s.Last,
+		BaseVolume:     s.BaseVolume,
+		TimeStamp:      timeToPB(s.TimeStamp.Get()),
+		Bid:            s.Bid,
+		Ask:            s.Ask,
+		OpenBuyOrders:  int32(s.OpenBuyOrders),
+		OpenSellOrders: int32(s.OpenSellOrders),
+		PrevDay:        s.PrevDay,
+		Created:        timeToPB(s.Created.Get()),
+	}
+}
+
+func summaryDeltaFromPB(s *bittrexpb.MarketSummary) socketPayloads.Summary {
+	summary := socketPayloads.Summary{
+		MarketName:     s.MarketName,
+		High:           s.High,
+		Low:            s.Low,
+		Volume:         s.Volume,
+		Last:           s.Last,
+		BaseVolume:     s.BaseVolume,
+		Bid:            s.Bid,
+		Ask:            s.Ask,
+		OpenBuyOrders:  int(s.OpenBuyOrders),
+		OpenSellOrders: int(s.OpenSellOrders),
+		PrevDay:        s.PrevDay,
+	}
+	summary.TimeStamp.Set(timeFromPB(s.TimeStamp))
+	summary.Created.Set(timeFromPB(s.Created))
+
+	return summary
+}
+
+//operations socketPayloads' operation types, indexed by their protobuf enum value.
+var operations = []socketPayloads.ExchangeOrder{
+	bittrexpb.OrderOperation_ORDER_OPERATION_ADD:    {Type: socketPayloads.Add},
+	bittrexpb.OrderOperation_ORDER_OPERATION_REMOVE: {Type: socketPayloads.Remove},
+	bittrexpb.OrderOperation_ORDER_OPERATION_UPDATE: {Type: socketPayloads.Update},
+	bittrexpb.OrderOperation_ORDER_OPERATION_CANCEL: {Type: socketPayloads.Cancel},
+}
+
+func exchangeOrdersToPB(orders []socketPayloads.ExchangeOrder) []*bittrexpb.ExchangeOrder {
+	converted := make([]*bittrexpb.ExchangeOrder, 0, len(orders))
+	for _, order := range orders {
+		operation := bittrexpb.OrderOperation_ORDER_OPERATION_ADD
+		for value, candidate := range operations {
+			if candidate.Type == order.Type {
+				operation = bittrexpb.OrderOperation(value)
+			}
+		}
+
+		converted = append(converted, &bittrexpb.ExchangeOrder{Type: operation, Rate: order.Rate, Quantity: order.Quantity})
+	}
+
+	return converted
+}
+
+func exchangeOrdersFromPB(orders []*bittrexpb.ExchangeOrder) []socketPayloads.ExchangeOrder {
+	converted := make([]socketPayloads.ExchangeOrder, 0, len(orders))
+	for _, order := range orders {
+		var exchangeOrder socketPayloads.ExchangeOrder
+		if int(order.Type) >= 0 && int(order.Type) < len(operations) {
+			exchangeOrder = operations[order.Type]
+		}
+
+		exchangeOrder.Rate = order.Rate
+		exchangeOrder.Quantity = order.Quantity
+		converted = append(converted, exchangeOrder)
+	}
+
+	return converted
+}
+
+func exchangeDeltaToPB(d socketPayloads.ExchangeDelta) *bittrexpb.ExchangeDelta {
+	delta := &bittrexpb.ExchangeDelta{
+		MarketName: d.MarketName,
+		Nonce:      int64(d.Nonce),
+		Buys:       exchangeOrdersToPB(d.Buys),
+		Sells:      exchangeOrdersToPB(d.Sells),
+	}
+
+	for _, fill := range d.Fills {
+		delta.Fills = append(delta.Fills, &bittrexpb.ExchangeFill{
+			FillId:    int64(fill.FillID),
+			OrderType: fill.OrderType,
+			Rate:      fill.Rate,
+			Quantity:  fill.Quantity,
+			TimeStamp: timeToPB(fill.TimeStamp.Get()),
+		})
+	}
+
+	return delta
+}
+
+func exchangeDeltaFromPB(d *bittrexpb.ExchangeDelta) socketPayloads.ExchangeDelta {
+	delta := socketPayloads.ExchangeDelta{
+		MarketName: d.MarketName,
+		Nonce:      int(d.Nonce),
+		Buys:       exchangeOrdersFromPB(d.Buys),
+		Sells:      exchangeOrdersFromPB(d.Sells),
+	}
+
+	for _, fill := range d.Fills {
+		exchangeFill := socketPayloads.ExchangeFill{
+			FillID:    int(fill.FillId),
+			OrderType: fill.OrderType,
+			Rate:      fill.Rate,
+			Quantity:  fill.Quantity,
+		}
+		exchangeFill.TimeStamp.Set(timeFromPB(fill.TimeStamp))
+		delta.Fills = append(delta.Fills, exchangeFill)
+	}
+
+	return delta
+}
+
+func orderDeltaToPB(r socketPayloads.OrderResponse) *bittrexpb.OrderDelta {
+	o := r.Order
+
+	return &bittrexpb.OrderDelta{
+		AccountUuid: r.AccountUUID,
+		Nonce:       int64(r.Nonce),
+		Type:        bittrexpb.OrderDeltaType(r.Type),
+		Order: &bittrexpb.SocketOrder{
+			Uuid:              o.UUID,
+			Id:                o.ID,
+			OrderUuid:         o.OrderUUID,
+			Exchange:          o.Exchange,
+			OrderType:         o.OrderType,
+			Quantity:          o.Quantity,
+			QuantityRemaining: o.QuantityRemaining,
+			Limit:             o.Limit,
+			CommissionPaid:    o.CommissionPaid,
+			Price:             o.Price,
+			PricePerUnit:      o.PricePerUnit,
+			Opened:            timeToPB(o.Opened.Get()),
+			Closed:            timeToPB(o.Closed.Get()),
+			IsOpen:            o.IsOpen,
+			CancelInitiated:   o.CancelInitiated,
+			ImmediateOrCancel: o.ImmediateOrCancel,
+			IsConditional:     o.IsConditional,
+			Condition:         o.Condition,
+			ConditionTarget:   o.ConditionTarget,
+			Updated:           timeToPB(o.Updated.Get()),
+		},
+	}
+}
+
+func orderDeltaFromPB(d *bittrexpb.OrderDelta) socketPayloads.OrderResponse {
+	response := socketPayloads.OrderResponse{
+		AccountUUID: d.AccountUuid,
+		Nonce:       int(d.Nonce),
+		Type:        int(d.Type),
+	}
+
+	if o := d.Order; o != nil {
+		response.Order = socketPayloads.Order{
+			UUID:              o.Uuid,
+			ID:                o.Id,
+			OrderUUID:         o.OrderUuid,
+			Exchange:          o.Exchange,
+			OrderType:         o.OrderType,
+			Quantity:          o.Quantity,
+			QuantityRemaining: o.QuantityRemaining,
+			Limit:             o.Limit,
+			CommissionPaid:    o.CommissionPaid,
+			Price:             o.Price,
+			PricePerUnit:      o.PricePerUnit,
+			IsOpen:            o.IsOpen,
+			CancelInitiated:   o.CancelInitiated,
+			ImmediateOrCancel: o.ImmediateOrCancel,
+			IsConditional:     o.IsConditional,
+			Condition:         o.Condition,
+			ConditionTarget:   o.ConditionTarget,
+		}
+		response.Order.Opened.Set(timeFromPB(o.Opened))
+		response.Order.Closed.Set(timeFromPB(o.Closed))
+		response.Order.Updated.Set(timeFromPB(o.Updated))
+	}
+
+	return response
+}
+
+func balanceDeltaToPB(b socketPayloads.BalanceDelta) *bittrexpb.BalanceDelta {
+	return &bittrexpb.BalanceDelta{
+		Uuid:          b.UUID,
+		AccountId:     int64(b.AccountID),
+		Currency:      b.Currency,
+		Balance:       b.Balance,
+		Available:     b.Available,
+		Pending:       b.Pending,
+		CryptoAddress: b.CryptoAddress,
+		Requested:     b.Requested,
+		Updated:       timeToPB(b.Updated.Get()),
+		AutoSell:      b.AutoSell,
+	}
+}
+
+func balanceDeltaFromPB(b *bittrexpb.BalanceDelta) socketPayloads.BalanceDelta {
+	delta := socketPayloads.BalanceDelta{
+		UUID:          b.Uuid,
+		AccountID:     int(b.AccountId),
+		Currency:      b.Currency,
+		Balance:       b.Balance,
+		Available:     b.Available,
+		Pending:       b.Pending,
+		CryptoAddress: b.CryptoAddress,
+		Requested:     b.Requested,
+		AutoSell:      b.AutoSell,
+	}
+	delta.Updated.Set(timeFromPB(b.Updated))
+
+	return delta
+}
@@ -0,0 +1,558 @@
+/*
+Package rpc serves a bittrex Client over gRPC, and provides a Client that implements bittrex.API against such a
+server, so services in other languages, and Go services without credentials of their own, share one connection.
+
+The service is defined in bittrexpb/bittrex.proto; generate stubs for other languages from it.
+
+Whoever can call the server acts with the client's API key.  A Server answers public market data only, unless its
+Config turns on account reads, trading or withdrawals; trading and withdrawals also need an Authorize hook.  Serve
+on localhost, or over TLS with Authorize checking the caller, and never give the key withdrawal permission unless
+Config.Withdraw is meant to be used.
+*/
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/rpc/bittrexpb"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//Config arguments for NewServer.  The zero Config serves public market data and the summary and exchange streams.
+type Config struct {
+	//Account serve balances, deposit addresses, orders and histories, and the order and balance streams.
+	Account bool
+	//Trading serve BuyLimit, SellLimit, Cancel and PlaceOrder.  Requires Authorize.
+	Trading bool
+	//Withdraw serve Withdraw.  Requires Authorize.
+	Withdraw bool
+	/*
+		Authorize called before every account, trading and withdrawal call with the full method name, e.g.
+		bittrexpb.Bittrex_Withdraw_FullMethodName.  A non-nil error refuses the call; a status error is returned as
+		is, anything else as PERMISSION_DENIED.  Credentials are usually read from the peer's TLS certificate or
+		from the call's metadata.
+	*/
+	Authorize func(ctx context.Context, method string) error
+	//StreamBuffer deltas a stream may fall behind by before it is ended.  defaults to 256.
+	StreamBuffer int
+}
+
+/*
+Server the Bittrex gRPC service, answered by a bittrex Client.  Streams are fed from the client's socket listeners, so
+any number of them can run alongside the client's own Subscribe channels.  The client's socket must be connected
+before streams are opened, and authenticated for the order and balance streams.
+*/
+type Server struct {
+	bittrexpb.UnimplementedBittrexServer
+
+	client *bittrex.Client
+	config Config
+}
+
+//NewServer serve client.  Register it with a grpc.Server before serving.
+func NewServer(client *bittrex.Client, config Config) (*Server, error) {
+	if (config.Trading || config.Withdraw) && config.Authorize == nil {
+		return nil, fmt.Errorf("rpc - trading and withdrawals require an Authorize hook")
+	}
+
+	if config.StreamBuffer <= 0 {
+		config.StreamBuffer = 256
+	}
+
+	return &Server{client: client, config: config}, nil
+}
+
+//Register add the service to registrar, usually a *grpc.Server.
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	bittrexpb.RegisterBittrexServer(registrar, s)
+}
+
+//permit refuse method unless enabled by the Config and allowed by Authorize.
+func (s *Server) permit(ctx context.Context, method string, enabled bool) error {
+	if !enabled {
+		return status.Error(codes.PermissionDenied, method+" is not enabled on this server")
+	}
+
+	if s.config.Authorize == nil {
+		return nil
+	}
+
+	authErr := s.config.Authorize(ctx, method)
+	if authErr == nil {
+		return nil
+	}
+
+	if _, isStatus := status.FromError(authErr); isStatus {
+		return authErr
+	}
+
+	return status.Error(codes.PermissionDenied, authErr.Error())
+}
+
+//toStatus map the library's errors onto status codes, as described in bittrex.proto.
+func toStatus(err error) error {
+	switch typed := err.(type) {
+	case nil:
+		return nil
+	case bittrex.APIError:
+		return status.Error(codes.FailedPrecondition, typed.Message)
+	case bittrex.OrderAcceptedError:
+		return status.Error(codes.DataLoss, typed.Reason)
+	case bittrex.OrderValidationError, bittrex.MarketValidationError, bittrex.DustOrderError,
+		bittrex.RiskViolationError, bittrex.SessionTrippedError, bittrex.WithdrawalPolicyError:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return status.Error(codes.Unknown, err.Error())
+}
+
+//GetMarkets PublicGetMarkets
+func (s *Server) GetMarkets(ctx context.Context, request *bittrexpb.GetMarketsRequest) (*bittrexpb.GetMarketsResponse, error) {
+	markets, callErr := s.client.PublicGetMarkets()
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetMarketsResponse{}
+	for _, market := range markets {
+		response.Markets = append(response.Markets, marketToPB(market))
+	}
+
+	return response, nil
+}
+
+//GetCurrencies PublicGetCurrencies
+func (s *Server) GetCurrencies(ctx context.Context, request *bittrexpb.GetCurrenciesRequest) (*bittrexpb.GetCurrenciesResponse, error) {
+	currencies, callErr := s.client.PublicGetCurrencies()
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetCurrenciesResponse{}
+	for _, currency := range currencies {
+		response.Currencies = append(response.Currencies, currencyToPB(currency))
+	}
+
+	return response, nil
+}
+
+//GetTicker PublicGetTicker
+func (s *Server) GetTicker(ctx context.Context, request *bittrexpb.MarketRequest) (*bittrexpb.Ticker, error) {
+	ticker, callErr := s.client.PublicGetTicker(request.Market)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.Ticker{Bid: ticker.Bid, Ask: ticker.Ask, Last: ticker.Last}, nil
+}
+
+//GetMarketSummaries PublicGetMarketSummaries
+func (s *Server) GetMarketSummaries(ctx context.Context, request *bittrexpb.GetMarketSummariesRequest) (*bittrexpb.GetMarketSummariesResponse, error) {
+	summaries, callErr := s.client.PublicGetMarketSummaries()
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetMarketSummariesResponse{}
+	for _, summary := range summaries {
+		response.Summaries = append(response.Summaries, marketSummaryToPB(summary))
+	}
+
+	return response, nil
+}
+
+//GetMarketSummary PublicGetMarketSummary
+func (s *Server) GetMarketSummary(ctx context.Context, request *bittrexpb.MarketRequest) (*bittrexpb.MarketSummary, error) {
+	summary, callErr := s.client.PublicGetMarketSummary(request.Market)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return marketSummaryToPB(summary), nil
+}
+
+//GetOrderBook PublicGetOrderBook
+func (s *Server) GetOrderBook(ctx context.Context, request *bittrexpb.GetOrderBookRequest) (*bittrexpb.OrderBook, error) {
+	bookType := request.Type
+	if bookType == "" {
+		bookType = "both"
+	}
+
+	book, callErr := s.client.PublicGetOrderBook(request.Market, bookType)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.OrderBook{Buy: orderBookSideToPB(book.Buy), Sell: orderBookSideToPB(book.Sell)}, nil
+}
+
+//GetMarketHistory PublicGetMarketHistory
+func (s *Server) GetMarketHistory(ctx context.Context, request *bittrexpb.MarketRequest) (*bittrexpb.GetMarketHistoryResponse, error) {
+	trades, callErr := s.client.PublicGetMarketHistory(request.Market)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetMarketHistoryResponse{}
+	for _, trade := range trades {
+		response.Trades = append(response.Trades, tradeToPB(trade))
+	}
+
+	return response, nil
+}
+
+//GetBalances AccountGetBalances
+func (s *Server) GetBalances(ctx context.Context, request *bittrexpb.GetBalancesRequest) (*bittrexpb.GetBalancesResponse, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetBalances_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	balances, callErr := s.client.AccountGetBalances()
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetBalancesResponse{}
+	for _, balance := range balances {
+		response.Balances = append(response.Balances, balanceToPB(balance))
+	}
+
+	return response, nil
+}
+
+//GetBalance AccountGetBalance
+func (s *Server) GetBalance(ctx context.Context, request *bittrexpb.CurrencyRequest) (*bittrexpb.AccountBalance, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetBalance_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	balance, callErr := s.client.AccountGetBalance(request.Currency)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return balanceToPB(balance), nil
+}
+
+//GetDepositAddress AccountGetDepositAddress
+func (s *Server) GetDepositAddress(ctx context.Context, request *bittrexpb.CurrencyRequest) (*bittrexpb.WalletAddress, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetDepositAddress_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	address, callErr := s.client.AccountGetDepositAddress(request.Currency)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.WalletAddress{Currency: address.Currency, Address: address.Address}, nil
+}
+
+//Withdraw AccountWithdraw
+func (s *Server) Withdraw(ctx context.Context, request *bittrexpb.WithdrawRequest) (*bittrexpb.TransactionID, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_Withdraw_FullMethodName, s.config.Withdraw); permitErr != nil {
+		return nil, permitErr
+	}
+
+	id, callErr := s.client.AccountWithdraw(request.Currency, request.Quantity, request.Address, request.PaymentId)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.TransactionID{Uuid: id.UUID}, nil
+}
+
+//GetOrder AccountGetOrder
+func (s *Server) GetOrder(ctx context.Context, request *bittrexpb.GetOrderRequest) (*bittrexpb.AccountOrder, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetOrder_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	order, callErr := s.client.AccountGetOrder(request.OrderUuid)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return accountOrderToPB(order), nil
+}
+
+//GetOrderHistory AccountGetOrderHistory
+func (s *Server) GetOrderHistory(ctx context.Context, request *bittrexpb.MarketRequest) (*bittrexpb.GetOrderHistoryResponse, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetOrderHistory_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	orders, callErr := s.client.AccountGetOrderHistory(request.Market)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetOrderHistoryResponse{}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, orderHistoryToPB(order))
+	}
+
+	return response, nil
+}
+
+//GetWithdrawalHistory AccountGetWithdrawalHistory
+func (s *Server) GetWithdrawalHistory(ctx context.Context, request *bittrexpb.CurrencyRequest) (*bittrexpb.GetTransferHistoryResponse, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetWithdrawalHistory_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	withdrawals, callErr := s.client.AccountGetWithdrawalHistory(request.Currency)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return transfersToPB(withdrawals), nil
+}
+
+//GetDepositHistory AccountGetDepositHistory
+func (s *Server) GetDepositHistory(ctx context.Context, request *bittrexpb.CurrencyRequest) (*bittrexpb.GetTransferHistoryResponse, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetDepositHistory_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	deposits, callErr := s.client.AccountGetDepositHistory(request.Currency)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return transfersToPB(deposits), nil
+}
+
+//BuyLimit MarketBuyLimit
+func (s *Server) BuyLimit(ctx context.Context, request *bittrexpb.LimitOrderRequest) (*bittrexpb.TransactionID, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_BuyLimit_FullMethodName, s.config.Trading); permitErr != nil {
+		return nil, permitErr
+	}
+
+	id, callErr := s.client.MarketBuyLimit(request.Market, request.Quantity, request.Rate)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.TransactionID{Uuid: id.UUID}, nil
+}
+
+//SellLimit MarketSellLimit
+func (s *Server) SellLimit(ctx context.Context, request *bittrexpb.LimitOrderRequest) (*bittrexpb.TransactionID, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_SellLimit_FullMethodName, s.config.Trading); permitErr != nil {
+		return nil, permitErr
+	}
+
+	id, callErr := s.client.MarketSellLimit(request.Market, request.Quantity, request.Rate)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.TransactionID{Uuid: id.UUID}, nil
+}
+
+//Cancel MarketCancel
+func (s *Server) Cancel(ctx context.Context, request *bittrexpb.CancelRequest) (*bittrexpb.CancelResponse, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_Cancel_FullMethodName, s.config.Trading); permitErr != nil {
+		return nil, permitErr
+	}
+
+	cancelled, callErr := s.client.MarketCancel(request.Uuid)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return &bittrexpb.CancelResponse{Cancelled: cancelled}, nil
+}
+
+//GetOpenOrders MarketGetOpenOrders
+func (s *Server) GetOpenOrders(ctx context.Context, request *bittrexpb.MarketRequest) (*bittrexpb.GetOpenOrdersResponse, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_GetOpenOrders_FullMethodName, s.config.Account); permitErr != nil {
+		return nil, permitErr
+	}
+
+	orders, callErr := s.client.MarketGetOpenOrders(request.Market)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetOpenOrdersResponse{}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, openOrderToPB(order))
+	}
+
+	return response, nil
+}
+
+//GetTicks PubMarketGetTicks
+func (s *Server) GetTicks(ctx context.Context, request *bittrexpb.GetTicksRequest) (*bittrexpb.GetTicksResponse, error) {
+	candles, callErr := s.client.PubMarketGetTicks(request.Market, request.Interval)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	response := &bittrexpb.GetTicksResponse{}
+	for _, candle := range candles {
+		response.Candles = append(response.Candles, candleToPB(candle))
+	}
+
+	return response, nil
+}
+
+//GetLatestTick PubMarketGetLatestTick
+func (s *Server) GetLatestTick(ctx context.Context, request *bittrexpb.GetTicksRequest) (*bittrexpb.Candle, error) {
+	candle, callErr := s.client.PubMarketGetLatestTick(request.Market, request.Interval)
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return candleToPB(candle), nil
+}
+
+//PlaceOrder PlaceOrder
+func (s *Server) PlaceOrder(ctx context.Context, request *bittrexpb.OrderRequest) (*bittrexpb.PlacedOrder, error) {
+	if permitErr := s.permit(ctx, bittrexpb.Bittrex_PlaceOrder_FullMethodName, s.config.Trading); permitErr != nil {
+		return nil, permitErr
+	}
+
+	placed, callErr := s.client.PlaceOrder(orderRequestFromPB(request))
+	if callErr != nil {
+		return nil, toStatus(callErr)
+	}
+
+	return placedOrderToPB(placed), nil
+}
+
+//relay hands deltas from a socket listener, which must not block, to the goroutine sending them on a stream.
+type relay struct {
+	deltas       chan interface{}
+	overflow     chan struct{}
+	overflowOnce sync.Once
+}
+
+func (s *Server) newRelay() *relay {
+	return &relay{
+		deltas:   make(chan interface{}, s.config.StreamBuffer),
+		overflow: make(chan struct{}),
+	}
+}
+
+func (r *relay) offer(delta interface{}) {
+	select {
+	case r.deltas <- delta:
+	default:
+		r.overflowOnce.Do(func() { close(r.overflow) })
+	}
+}
+
+//run send deltas until the caller goes away, sending fails, or the stream falls behind.
+func (r *relay) run(ctx context.Context, send func(delta interface{}) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.overflow:
+			return status.Error(codes.ResourceExhausted, "stream fell behind the socket")
+		case delta := <-r.deltas:
+			if sendErr := send(delta); sendErr != nil {
+				return sendErr
+			}
+		}
+	}
+}
+
+func marketFilter(markets []string) map[string]bool {
+	filter := make(map[string]bool, len(markets))
+	for _, market := range markets {
+		filter[strings.ToUpper(market)] = true
+	}
+
+	return filter
+}
+
+//SubscribeSummaries summary deltas of the requested markets, or of every market.
+func (s *Server) SubscribeSummaries(request *bittrexpb.SubscribeRequest, stream bittrexpb.Bittrex_SubscribeSummariesServer) error {
+	filter := marketFilter(request.Markets)
+	r := s.newRelay()
+
+	remove, listenErr := s.client.OnSummaryDelta(func(summary socketPayloads.Summary) {
+		if len(filter) == 0 || filter[summary.MarketName] {
+			r.offer(summaryDeltaToPB(summary))
+		}
+	})
+	if listenErr != nil {
+		return toStatus(listenErr)
+	}
+	defer remove()
+
+	return r.run(stream.Context(), func(delta interface{}) error {
+		return stream.Send(delta.(*bittrexpb.MarketSummary))
+	})
+}
+
+//SubscribeExchange exchange deltas of the requested markets.  At least one market is required.
+func (s *Server) SubscribeExchange(request *bittrexpb.SubscribeRequest, stream bittrexpb.Bittrex_SubscribeExchangeServer) error {
+	if len(request.Markets) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one market is required")
+	}
+
+	r := s.newRelay()
+
+	for market := range marketFilter(request.Markets) {
+		remove, listenErr := s.client.OnExchangeDelta(market, func(delta socketPayloads.ExchangeDelta) {
+			r.offer(exchangeDeltaToPB(delta))
+		})
+		if listenErr != nil {
+			return toStatus(listenErr)
+		}
+		defer remove()
+	}
+
+	return r.run(stream.Context(), func(delta interface{}) error {
+		return stream.Send(delta.(*bittrexpb.ExchangeDelta))
+	})
+}
+
+//SubscribeOrders the account's order deltas.
+func (s *Server) SubscribeOrders(request *bittrexpb.SubscribeOrdersRequest, stream bittrexpb.Bittrex_SubscribeOrdersServer) error {
+	if permitErr := s.permit(stream.Context(), bittrexpb.Bittrex_SubscribeOrders_FullMethodName, s.config.Account); permitErr != nil {
+		return permitErr
+	}
+
+	r := s.newRelay()
+
+	remove := s.client.OnOrderDelta(func(order socketPayloads.OrderResponse) {
+		r.offer(orderDeltaToPB(order))
+	})
+	defer remove()
+
+	return r.run(stream.Context(), func(delta interface{}) error {
+		return stream.Send(delta.(*bittrexpb.OrderDelta))
+	})
+}
+
+//SubscribeBalances the account's balance deltas.
+func (s *Server) SubscribeBalances(request *bittrexpb.SubscribeBalancesRequest, stream bittrexpb.Bittrex_SubscribeBalancesServer) error {
+	if permitErr := s.permit(stream.Context(), bittrexpb.Bittrex_SubscribeBalances_FullMethodName, s.config.Account); permitErr != nil {
+		return permitErr
+	}
+
+	r := s.newRelay()
+
+	remove := s.client.OnBalanceDelta(func(balance socketPayloads.Balance) {
+		r.offer(balanceDeltaToPB(balance.BalanceDelta))
+	})
+	defer remove()
+
+	return r.run(stream.Context(), func(delta interface{}) error {
+		return stream.Send(delta.(*bittrexpb.BalanceDelta))
+	})
+}
@@ -12,7 +12,16 @@ import (
 
 type queryParams = map[string]string
 
+//sendRequest doRequest, timed and counted when metrics are attached.
 func (c *Client) sendRequest(endpoint string, params queryParams) (*baseResponse, error) {
+	started := time.Now()
+	response, err := c.doRequest(endpoint, params)
+	c.attachedMetrics().observeRequest(endpoint, time.Since(started), err)
+
+	return response, err
+}
+
+func (c *Client) doRequest(endpoint string, params queryParams) (*baseResponse, error) {
 	fullURI := c.getFullURI(endpoint, params)
 
 	sign := c.sign(fullURI)
@@ -34,7 +43,7 @@ func (c *Client) sendRequest(endpoint string, params queryParams) (*baseResponse
 	clientTimer := time.NewTimer(c.timeout)
 
 	go func() {
-		httpClient := &http.Client{}
+		httpClient := &http.Client{Transport: c.httpTransport}
 		if resp, respErr = httpClient.Do(request); respErr != nil {
 			done <- fmt.Errorf("sendRequest - do request: %s", respErr.Error())
 		}
@@ -78,7 +87,7 @@ func (c *Client) sendRequest(endpoint string, params queryParams) (*baseResponse
 	}
 
 	if response.Success == false {
-		return nil, fmt.Errorf("Send Request Endpoint - %s: %s", endpoint, response.Message)
+		return nil, APIError{Endpoint: endpoint, Message: response.Message}
 	}
 
 	return &response, nil
@@ -0,0 +1,190 @@
+package bittrex_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/cassette"
+)
+
+//newCassetteClient a client answered from testdata/cassette.json, so no request reaches the network.
+func newCassetteClient(t *testing.T) *bittrex.Client {
+	player, loadErr := cassette.LoadPlayer("testdata/cassette.json")
+	if loadErr != nil {
+		t.Fatal(loadErr)
+	}
+
+	client, _ := bittrex.New("key", "secret")
+	client.SetHTTPTransport(player)
+
+	return client
+}
+
+func TestCassettePublicGetTicker(t *testing.T) {
+	ticker, tickerErr := newCassetteClient(t).PublicGetTicker("BTC-LTC")
+	if tickerErr != nil {
+		t.Fatal(tickerErr)
+	}
+
+	if ticker.Bid != 0.0161 || ticker.Ask != 0.0161738 || ticker.Last != 0.0161 {
+		t.Errorf("unexpected ticker %+v", ticker)
+	}
+}
+
+func TestCassettePublicGetMarketSummary(t *testing.T) {
+	summary, summaryErr := newCassetteClient(t).PublicGetMarketSummary("BTC-LTC")
+	if summaryErr != nil {
+		t.Fatal(summaryErr)
+	}
+
+	if summary.MarketName != "BTC-LTC" || summary.OpenSellOrders != 4127 || summary.Volume != 21330.8236462 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+}
+
+func TestCassetteAddressIsRedacted(t *testing.T) {
+	address, addressErr := newCassetteClient(t).AccountGetDepositAddress("LTC")
+	if addressErr != nil {
+		t.Fatal(addressErr)
+	}
+
+	if address.Currency != "LTC" || address.Address != cassette.Redacted {
+		t.Errorf("unexpected address %+v", address)
+	}
+}
+
+func TestCassettePublicGetOrderBook(t *testing.T) {
+	book, bookErr := newCassetteClient(t).PublicGetOrderBook("BTC-LTC", "both")
+	if bookErr != nil {
+		t.Fatal(bookErr)
+	}
+
+	if len(book.Buy) != 2 || len(book.Sell) != 1 || book.Buy[0].Rate != 0.0161 || book.Sell[0].Quantity != 4.1 {
+		t.Errorf("unexpected order book %+v", book)
+	}
+}
+
+func TestCassetteAccountGetOrderHistory(t *testing.T) {
+	orders, historyErr := newCassetteClient(t).AccountGetOrderHistory("BTC-LTC")
+	if historyErr != nil {
+		t.Fatal(historyErr)
+	}
+
+	if len(orders) != 1 {
+		t.Fatalf("expected one order, got %d", len(orders))
+	}
+
+	order := orders[0]
+	if order.OrderUUID != "fd97d393-e9b9-4dd1-9dbf-f288fc72a185" || order.OrderType != "LIMIT_SELL" || order.Commission != 0.00000402 {
+		t.Errorf("unexpected order %+v", order)
+	}
+}
+
+//redirectTransport send every request to target instead of the exchange.
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (r redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	redirected := req.Clone(req.Context())
+	redirected.URL.Scheme = r.target.Scheme
+	redirected.URL.Host = r.target.Host
+
+	return http.DefaultTransport.RoundTrip(redirected)
+}
+
+func TestRecorderRedactsSecrets(t *testing.T) {
+	const address = "LhyLNfBkoKshT7R8Pce37fzBSJ8shXpb3f"
+
+	var sign, nonce string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sign = r.Header.Get("apisign")
+		nonce = r.URL.Query().Get("nonce")
+		w.Write([]byte(`{"success":true,"message":"","result":{"Currency":"LTC","Address":"` + address + `"}}`))
+	}))
+	defer server.Close()
+
+	target, _ := url.Parse(server.URL)
+	recorder := cassette.NewRecorder(redirectTransport{target})
+
+	client, _ := bittrex.New("recorder-key", "recorder-secret")
+	client.SetHTTPTransport(recorder)
+
+	deposit, addressErr := client.AccountGetDepositAddress("LTC")
+	if addressErr != nil {
+		t.Fatal(addressErr)
+	}
+
+	if deposit.Address != address {
+		t.Errorf("the caller should get the unredacted address, got %q", deposit.Address)
+	}
+
+	if sign == "" || nonce == "" {
+		t.Fatal("expected the request to carry an apisign header and a nonce")
+	}
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	if saveErr := recorder.Save(path); saveErr != nil {
+		t.Fatal(saveErr)
+	}
+
+	raw, readErr := ioutil.ReadFile(path)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+
+	for name, secret := range map[string]string{"apikey": "recorder-key", "apisign": sign, "address": address} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("the saved cassette contains the %s", name)
+		}
+	}
+
+	saved, loadErr := cassette.Load(path)
+	if loadErr != nil {
+		t.Fatal(loadErr)
+	}
+
+	if len(saved.Interactions) != 1 {
+		t.Fatalf("expected one interaction, got %d", len(saved.Interactions))
+	}
+
+	interaction := saved.Interactions[0]
+	if interaction.Endpoint != "v1.1/account/getdepositaddress" || interaction.Params["currency"] != "LTC" {
+		t.Errorf("unexpected interaction %+v", interaction)
+	}
+
+	for _, param := range []string{"apikey", "nonce", "_"} {
+		if _, ok := interaction.Params[param]; ok {
+			t.Errorf("the saved cassette kept the %s param", param)
+		}
+	}
+
+	if !strings.Contains(interaction.Body, `"Address":"`+cassette.Redacted+`"`) {
+		t.Errorf("expected the address to be redacted, got %s", interaction.Body)
+	}
+}
+
+func TestCassetteRefusalIsAPIError(t *testing.T) {
+	_, balanceErr := newCassetteClient(t).AccountGetBalance("XXX")
+
+	apiErr, ok := balanceErr.(bittrex.APIError)
+	if !ok {
+		t.Fatalf("expected an APIError, got %v", balanceErr)
+	}
+
+	if apiErr.Message != "INVALID_CURRENCY" {
+		t.Errorf("unexpected message %q", apiErr.Message)
+	}
+}
+
+func TestCassetteUnrecordedRequestFails(t *testing.T) {
+	if _, tickerErr := newCassetteClient(t).PublicGetTicker("BTC-ETH"); tickerErr == nil {
+		t.Error("expected an error for a request missing from the cassette")
+	}
+}
@@ -0,0 +1,323 @@
+package bittrex
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/signalr"
+)
+
+const (
+	//how often an armed guard checks the heartbeat and socket state when no CheckInterval is given.
+	defaultSessionGuardInterval time.Duration = time.Second
+	//attempts at listing the open orders before a trip gives up.
+	sessionGuardCancelAttempts int = 3
+)
+
+//TripReason why a SessionGuard tripped.
+type TripReason string
+
+//Trip Reasons
+const (
+	TripHeartbeatMissed    TripReason = "HEARTBEAT_MISSED"
+	TripSocketDisconnected TripReason = "SOCKET_DISCONNECTED"
+	TripManual             TripReason = "MANUAL"
+)
+
+//SessionGuardConfig arguments for ArmSessionGuard.  A zero timeout disables that check.
+type SessionGuardConfig struct {
+	//HeartbeatTimeout trip if Heartbeat is not called for this long.
+	HeartbeatTimeout time.Duration
+
+	//DisconnectTimeout trip if the websocket is not connected for this long.  Not checked until ConnectWebSocket has
+	//connected a socket, so a REST-only client is never tripped by it.
+	DisconnectTimeout time.Duration
+
+	//CheckInterval how often the heartbeat and socket state are checked.  defaults to one second.
+	CheckInterval time.Duration
+
+	//BlockOrders reject new orders from MarketBuyLimit, MarketSellLimit, KeyMarketTrade* and PlaceOrder once tripped,
+	//until Reset is called.
+	BlockOrders bool
+
+	//AuditPath optional file each trip is appended to, one json object per line.
+	AuditPath string
+
+	//OnTrip optional callback, called once the open orders have been cancelled.
+	OnTrip func(SessionGuardTrip)
+}
+
+//SessionGuardTrip audit record of one trip and the orders it cancelled.
+type SessionGuardTrip struct {
+	Time     time.Time
+	Reason   TripReason
+	Detail   string
+	Results  []CancelResult
+	Finished time.Time
+
+	//Err set if the open orders could not be listed, in which case nothing was cancelled.
+	Err error
+}
+
+//MarshalJSON write Err as its message, for the audit file.
+func (t SessionGuardTrip) MarshalJSON() ([]byte, error) {
+	type plain SessionGuardTrip
+
+	return json.Marshal(struct {
+		plain
+		Err string `json:",omitempty"`
+	}{plain(t), errorText(t.Err)})
+}
+
+//SessionTrippedError returned by the order methods while a tripped guard is blocking orders.
+type SessionTrippedError struct {
+	Reason TripReason
+	Since  time.Time
+}
+
+func (e SessionTrippedError) Error() string {
+	return fmt.Sprintf("session guard tripped (%s) at %s - new orders are blocked", e.Reason, e.Since.Format(time.RFC3339))
+}
+
+/*
+SessionGuard dead man's switch for a trading session.  Once armed it cancels every open order on the account when
+the heartbeat is missed, the websocket stays disconnected too long, or Trip is called.  A guard trips once; Reset
+re-arms it.
+*/
+type SessionGuard struct {
+	client *Client
+	config SessionGuardConfig
+
+	mutex             sync.RWMutex
+	lastHeartbeat     time.Time
+	disconnectedSince time.Time
+	tripped           bool
+	trippedAt         time.Time
+	trippedFor        TripReason
+	audit             []SessionGuardTrip
+
+	tripMutex sync.Mutex
+
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+//ArmSessionGuard start guarding the session, replacing any guard already armed on the client.
+func (c *Client) ArmSessionGuard(config SessionGuardConfig) (*SessionGuard, error) {
+	if config.HeartbeatTimeout < 0 || config.DisconnectTimeout < 0 {
+		return nil, fmt.Errorf("ArmSessionGuard - timeouts cannot be negative")
+	}
+
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaultSessionGuardInterval
+	}
+
+	g := &SessionGuard{
+		client:        c,
+		config:        config,
+		lastHeartbeat: time.Now(),
+		quit:          make(chan struct{}),
+	}
+
+	c.attachMutex.Lock()
+	previous := c.sessionGuard
+	c.sessionGuard = g
+	c.attachMutex.Unlock()
+
+	if previous != nil {
+		previous.Disarm()
+	}
+
+	if config.HeartbeatTimeout > 0 || config.DisconnectTimeout > 0 {
+		go g.monitor()
+	}
+
+	return g, nil
+}
+
+//Heartbeat tell the guard the session is alive.
+func (g *SessionGuard) Heartbeat() {
+	g.mutex.Lock()
+	g.lastHeartbeat = time.Now()
+	g.mutex.Unlock()
+}
+
+//Trip cancel every open order now.  Returns the audit record; if the guard had already tripped, nothing is cancelled.
+func (g *SessionGuard) Trip(detail string) SessionGuardTrip {
+	return g.trip(TripManual, detail)
+}
+
+//Tripped whether the guard has tripped since it was armed or last reset.
+func (g *SessionGuard) Tripped() bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.tripped
+}
+
+//Reset allow orders again and restart the heartbeat and disconnect timers.
+func (g *SessionGuard) Reset() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.tripped = false
+	g.lastHeartbeat = time.Now()
+	g.disconnectedSince = time.Time{}
+}
+
+//Audit every trip since the guard was armed, oldest first.
+func (g *SessionGuard) Audit() []SessionGuardTrip {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return append([]SessionGuardTrip(nil), g.audit...)
+}
+
+//Disarm stop guarding.  Orders are no longer blocked, even if the guard had tripped.
+func (g *SessionGuard) Disarm() {
+	g.closeOnce.Do(func() {
+		close(g.quit)
+
+		g.client.attachMutex.Lock()
+		if g.client.sessionGuard == g {
+			g.client.sessionGuard = nil
+		}
+		g.client.attachMutex.Unlock()
+	})
+}
+
+//attachedSessionGuard the guard armed on the client, if any.
+func (c *Client) attachedSessionGuard() *SessionGuard {
+	c.attachMutex.RLock()
+	defer c.attachMutex.RUnlock()
+
+	return c.sessionGuard
+}
+
+//blocking the error to return from the order methods, if any.
+func (g *SessionGuard) blocking() error {
+	if !g.config.BlockOrders {
+		return nil
+	}
+
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	if !g.tripped {
+		return nil
+	}
+
+	return SessionTrippedError{Reason: g.trippedFor, Since: g.trippedAt}
+}
+
+func (g *SessionGuard) monitor() {
+	ticker := time.NewTicker(g.config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.quit:
+			return
+		case now := <-ticker.C:
+			if reason, detail, trip := g.check(now); trip {
+				g.trip(reason, detail)
+			}
+		}
+	}
+}
+
+//check returns true with the reason if the session should be tripped.
+func (g *SessionGuard) check(now time.Time) (TripReason, string, bool) {
+	socket := g.client.socket()
+	connected := socket != nil && socket.State() == signalr.Connected
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.tripped {
+		return "", "", false
+	}
+
+	//a client that never connected a socket is REST-only, so there is no connection to lose.
+	if socket == nil || connected {
+		g.disconnectedSince = time.Time{}
+	} else if g.disconnectedSince.IsZero() {
+		g.disconnectedSince = now
+	}
+
+	if timeout := g.config.HeartbeatTimeout; timeout > 0 && now.Sub(g.lastHeartbeat) > timeout {
+		return TripHeartbeatMissed, fmt.Sprintf("no heartbeat since %s", g.lastHeartbeat.Format(time.RFC3339)), true
+	}
+
+	if timeout := g.config.DisconnectTimeout; timeout > 0 && !g.disconnectedSince.IsZero() && now.Sub(g.disconnectedSince) > timeout {
+		return TripSocketDisconnected, fmt.Sprintf("websocket disconnected since %s", g.disconnectedSince.Format(time.RFC3339)), true
+	}
+
+	return "", "", false
+}
+
+func (g *SessionGuard) trip(reason TripReason, detail string) SessionGuardTrip {
+	record := SessionGuardTrip{Time: time.Now(), Reason: reason, Detail: detail}
+
+	g.mutex.Lock()
+	if g.tripped {
+		record.Err = fmt.Errorf("session guard already tripped (%s)", g.trippedFor)
+		g.mutex.Unlock()
+		return record
+	}
+
+	//orders are blocked before cancelling starts, so nothing new lands while the book is cleared.
+	g.tripped = true
+	g.trippedAt = record.Time
+	g.trippedFor = reason
+	g.mutex.Unlock()
+
+	g.tripMutex.Lock()
+	defer g.tripMutex.Unlock()
+
+	for attempt := 0; attempt < sessionGuardCancelAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * time.Second)
+		}
+
+		record.Results, record.Err = g.client.CancelAllOrders()
+		if record.Err == nil {
+			break
+		}
+	}
+
+	record.Finished = time.Now()
+
+	g.mutex.Lock()
+	g.audit = append(g.audit, record)
+	g.mutex.Unlock()
+
+	if g.config.AuditPath != "" {
+		if auditErr := appendAuditRecord(g.config.AuditPath, record); auditErr != nil {
+			g.client.socketOnErrorMethod(auditErr)
+		}
+	}
+
+	if g.config.OnTrip != nil {
+		g.config.OnTrip(record)
+	}
+
+	return record
+}
+
+func appendAuditRecord(path string, record SessionGuardTrip) error {
+	file, openErr := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if openErr != nil {
+		return fmt.Errorf("session guard audit - open %s: %s", path, openErr.Error())
+	}
+	defer file.Close()
+
+	if encodeErr := json.NewEncoder(file).Encode(record); encodeErr != nil {
+		return fmt.Errorf("session guard audit - write %s: %s", path, encodeErr.Error())
+	}
+
+	return file.Sync()
+}
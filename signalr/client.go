@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -24,6 +25,22 @@ const (
 	Connected
 )
 
+//String implement stringer interface, eg "CONNECTED".
+func (cs ClientState) String() string {
+	switch cs {
+	case Disconnected:
+		return "DISCONNECTED"
+	case Connecting:
+		return "CONNECTING"
+	case Reconnecting:
+		return "RECONNECTING"
+	case Connected:
+		return "CONNECTED"
+	}
+
+	return "STATE(" + strconv.Itoa(int(cs)) + ")"
+}
+
 //Client object representing connection to the signalr socket api
 type Client struct {
 	//When errors happen for any reason, this callback is called.  This includes when the websocket closes remotely.
@@ -65,6 +82,14 @@ type Client struct {
 	hubs []string
 
 	maxRetries int
+
+	//optional raw frame recorder.
+	recorder      *Recorder
+	recorderMutex sync.RWMutex
+
+	//optional instrumentation.
+	observer      *Observer
+	observerMutex sync.RWMutex
 }
 
 //Close close the websocket connection
@@ -87,9 +112,13 @@ func (sc *Client) State() ClientState {
 
 func (sc *Client) setState(newState ClientState) {
 	sc.stateMutex.Lock()
-	defer sc.stateMutex.Unlock()
-
+	previous := sc.state
 	sc.state = newState
+	sc.stateMutex.Unlock()
+
+	if o := sc.getObserver(); o != nil && o.OnStateChange != nil && previous != newState {
+		o.OnStateChange(previous, newState)
+	}
 }
 
 func (sc *Client) updateKeepAlive() {
@@ -102,7 +102,9 @@ func (sc *Client) listenToWebSocket() chan serverMessage {
 
 			var message serverMessage
 			if err = json.Unmarshal(data, &message); err != nil {
-				sc.outputError(newError("Unable to parse message: %s\n", err.Error()))
+				parseErr := newError("Unable to parse message: %s\n", err.Error())
+				sc.observeDecodeError(parseErr)
+				sc.outputError(parseErr)
 				continue
 			}
 			socketDataChan <- message
@@ -123,11 +125,17 @@ func (sc *Client) handleSocketData(message serverMessage) {
 		var hubCall hubCallResponse
 
 		if err := json.Unmarshal(curData, &hubCall); err != nil {
-			sc.outputError(newError("Unable to unmarshal message data: %s", err.Error()))
+			unmarshalErr := newError("Unable to unmarshal message data: %s", err.Error())
+			sc.observeDecodeError(unmarshalErr)
+			sc.outputError(unmarshalErr)
 			continue
 		}
 
 		// check if this is a client Hub method call from server.
+		if hubCall.HubName != "" && hubCall.Method != "" {
+			sc.record(hubCall.HubName, hubCall.Method, hubCall.Arguments)
+		}
+
 		if hubCall.HubName != "" && hubCall.Method != "" && sc.OnClientMethod != nil {
 			go sc.OnClientMethod(hubCall.HubName, hubCall.Method, hubCall.Arguments)
 		}
@@ -0,0 +1,39 @@
+package signalr
+
+import "time"
+
+//Observer instrumentation callbacks.  Any of them may be nil.  They are called synchronously and must not block.
+type Observer struct {
+	//OnHubCall after every CallHub, with how long it took and the error it returned, if any.
+	OnHubCall func(hub, method string, duration time.Duration, err error)
+	//OnStateChange whenever the client moves to a new state, including each reconnect.
+	OnStateChange func(previous, current ClientState)
+	//OnDecodeError a frame or message from the server that could not be parsed.
+	OnDecodeError func(err error)
+}
+
+//SetObserver report hub calls, state changes and decode errors to o.  Set to nil to stop.
+func (sc *Client) SetObserver(o *Observer) {
+	sc.observerMutex.Lock()
+	sc.observer = o
+	sc.observerMutex.Unlock()
+}
+
+func (sc *Client) getObserver() *Observer {
+	sc.observerMutex.RLock()
+	defer sc.observerMutex.RUnlock()
+
+	return sc.observer
+}
+
+func (sc *Client) observeHubCall(hub, method string, started time.Time, err error) {
+	if o := sc.getObserver(); o != nil && o.OnHubCall != nil {
+		o.OnHubCall(hub, method, time.Since(started), err)
+	}
+}
+
+func (sc *Client) observeDecodeError(err error) {
+	if o := sc.getObserver(); o != nil && o.OnDecodeError != nil {
+		o.OnDecodeError(err)
+	}
+}
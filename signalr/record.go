@@ -0,0 +1,208 @@
+package signalr
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	recordFilePrefix    string = "signalr-"
+	recordFileExtension string = ".ndjson"
+	recordFileTimestamp string = "20060102T150405.000000000"
+)
+
+//Frame a single inbound client method argument as it came off the socket.
+type Frame struct {
+	Received time.Time       `json:"received"`
+	Hub      string          `json:"hub"`
+	Method   string          `json:"method"`
+	Argument json.RawMessage `json:"argument"` //still base64 encoded and deflated, exactly as received.
+}
+
+//Recorder writes every inbound frame to newline delimited json files within a directory, rotating by size.
+type Recorder struct {
+	dir      string
+	maxBytes int64
+	maxFiles int
+
+	mutex   sync.Mutex
+	file    *os.File
+	writer  *bufio.Writer
+	written int64
+	closed  bool
+}
+
+/*
+NewRecorder create a recorder writing into dir.
+maxBytes is the size at which the current file is closed and a new one opened.
+maxFiles is the number of files kept on disk, the oldest are removed first.  Zero or less for either disables that limit.
+*/
+func NewRecorder(dir string, maxBytes int64, maxFiles int) (*Recorder, error) {
+	if mkdirErr := os.MkdirAll(dir, 0755); mkdirErr != nil {
+		return nil, fmt.Errorf("unable to create recording directory %s: %+v", dir, mkdirErr)
+	}
+
+	r := &Recorder{
+		dir:      dir,
+		maxBytes: maxBytes,
+		maxFiles: maxFiles,
+	}
+
+	if rotateErr := r.rotate(); rotateErr != nil {
+		return nil, rotateErr
+	}
+
+	return r, nil
+}
+
+//Record write one frame per argument.  Errors are returned rather than panicking so a failing disk never takes down the socket.
+func (r *Recorder) Record(hub, method string, arguments []json.RawMessage) error {
+	received := time.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.closed {
+		return newError("recorder is closed")
+	}
+
+	for _, arg := range arguments {
+		line, marshalErr := json.Marshal(Frame{
+			Received: received,
+			Hub:      hub,
+			Method:   method,
+			Argument: arg,
+		})
+
+		if marshalErr != nil {
+			return marshalErr
+		}
+
+		if r.maxBytes > 0 && r.written > 0 && r.written+int64(len(line))+1 > r.maxBytes {
+			if rotateErr := r.rotate(); rotateErr != nil {
+				return rotateErr
+			}
+		}
+
+		n, writeErr := r.writer.Write(append(line, '\n'))
+		r.written += int64(n)
+
+		if writeErr != nil {
+			return writeErr
+		}
+	}
+
+	return r.writer.Flush()
+}
+
+//Close flush and close the current recording file.
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.closed {
+		return nil
+	}
+
+	r.closed = true
+
+	return r.closeFile()
+}
+
+func (r *Recorder) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+
+	flushErr := r.writer.Flush()
+	closeErr := r.file.Close()
+
+	r.file = nil
+	r.writer = nil
+
+	if flushErr != nil {
+		return flushErr
+	}
+
+	return closeErr
+}
+
+//rotate must be called with the mutex held (or before the recorder is shared).
+func (r *Recorder) rotate() error {
+	if closeErr := r.closeFile(); closeErr != nil {
+		return closeErr
+	}
+
+	name := filepath.Join(r.dir, recordFilePrefix+time.Now().UTC().Format(recordFileTimestamp)+recordFileExtension)
+
+	file, openErr := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if openErr != nil {
+		return fmt.Errorf("unable to open recording file %s: %+v", name, openErr)
+	}
+
+	r.file = file
+	r.writer = bufio.NewWriter(file)
+	r.written = 0
+
+	return r.prune()
+}
+
+func (r *Recorder) prune() error {
+	if r.maxFiles <= 0 {
+		return nil
+	}
+
+	files, listErr := RecordedFiles(r.dir)
+	if listErr != nil {
+		return listErr
+	}
+
+	for len(files) > r.maxFiles {
+		if removeErr := os.Remove(files[0]); removeErr != nil {
+			return removeErr
+		}
+		files = files[1:]
+	}
+
+	return nil
+}
+
+//RecordedFiles list the recording files within dir, oldest first.
+func RecordedFiles(dir string) ([]string, error) {
+	files, globErr := filepath.Glob(filepath.Join(dir, recordFilePrefix+"*"+recordFileExtension))
+	if globErr != nil {
+		return nil, globErr
+	}
+
+	//the timestamp format sorts lexically.
+	sort.Strings(files)
+
+	return files, nil
+}
+
+//SetRecorder record every inbound client method call.  Set to nil to stop recording.
+func (sc *Client) SetRecorder(r *Recorder) {
+	sc.recorderMutex.Lock()
+	sc.recorder = r
+	sc.recorderMutex.Unlock()
+}
+
+func (sc *Client) record(hub, method string, arguments []json.RawMessage) {
+	sc.recorderMutex.RLock()
+	r := sc.recorder
+	sc.recorderMutex.RUnlock()
+
+	if r == nil {
+		return
+	}
+
+	if recordErr := r.Record(hub, method, arguments); recordErr != nil {
+		sc.outputError(newError("unable to record frame: %s", recordErr.Error()))
+	}
+}
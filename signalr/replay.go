@@ -0,0 +1,140 @@
+package signalr
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+//maximum size of a single recorded line.  exchange state frames can get large.
+const replayMaxLineBytes int = 16 * 1024 * 1024
+
+//Replay feeds recorded frames back through an OnClientMethod style callback.
+type Replay struct {
+	files []string
+
+	//Speed multiplier applied to the gaps between frames.  1 replays at the original pace, 10 ten times faster.
+	//Zero or less replays every frame as fast as the handler accepts it.
+	Speed float64
+
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+//NewReplay create a replay source from recording files, played in the order given.
+func NewReplay(files ...string) *Replay {
+	return &Replay{
+		files: files,
+		Speed: 1,
+		stop:  make(chan struct{}),
+	}
+}
+
+//NewReplayFromDir create a replay source from every recording file within dir, oldest first.
+func NewReplayFromDir(dir string) (*Replay, error) {
+	files, listErr := RecordedFiles(dir)
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no recordings found in %s", dir)
+	}
+
+	return NewReplay(files...), nil
+}
+
+//Stop end a running Play early.
+func (r *Replay) Stop() {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+}
+
+/*
+Play call handler once per recorded frame, synchronously and in recorded order.
+Unlike the live dispatcher, handler is not started in its own goroutine, so tests can rely on ordering.
+*/
+func (r *Replay) Play(handler func(hub, method string, arguments []json.RawMessage)) error {
+	var previous time.Time
+
+	for _, name := range r.files {
+		stopped, playErr := r.playFile(name, handler, &previous)
+
+		if playErr != nil {
+			return playErr
+		}
+
+		if stopped {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (r *Replay) playFile(name string, handler func(hub, method string, arguments []json.RawMessage), previous *time.Time) (bool, error) {
+	file, openErr := os.Open(name)
+	if openErr != nil {
+		return false, fmt.Errorf("unable to open recording %s: %+v", name, openErr)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), replayMaxLineBytes)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var frame Frame
+		if parseErr := json.Unmarshal(scanner.Bytes(), &frame); parseErr != nil {
+			return false, fmt.Errorf("unable to parse %s line %d: %+v", name, line, parseErr)
+		}
+
+		if r.wait(*previous, frame.Received) {
+			return true, nil
+		}
+
+		*previous = frame.Received
+
+		handler(frame.Hub, frame.Method, []json.RawMessage{frame.Argument})
+	}
+
+	if scanErr := scanner.Err(); scanErr != nil {
+		return false, fmt.Errorf("unable to read %s: %+v", name, scanErr)
+	}
+
+	return false, nil
+}
+
+//wait sleep for the scaled gap between two frames.  returns true if the replay was stopped.
+func (r *Replay) wait(previous, next time.Time) bool {
+	select {
+	case <-r.stop:
+		return true
+	default:
+	}
+
+	if r.Speed <= 0 || previous.IsZero() || !next.After(previous) {
+		return false
+	}
+
+	gap := time.Duration(float64(next.Sub(previous)) / r.Speed)
+	timer := time.NewTimer(gap)
+	defer timer.Stop()
+
+	select {
+	case <-r.stop:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
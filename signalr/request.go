@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -38,6 +39,15 @@ func (sc *Client) sendHubMessage(data []byte) error {
 
 //CallHub Call server hub method. Dispatch() function must be running, otherewise this method will return an error.
 func (sc *Client) CallHub(hub, method string, params ...interface{}) (json.RawMessage, error) {
+	started := time.Now()
+
+	result, err := sc.callHub(hub, method, params)
+	sc.observeHubCall(hub, method, started, err)
+
+	return result, err
+}
+
+func (sc *Client) callHub(hub, method string, params []interface{}) (json.RawMessage, error) {
 	if !sc.isDispatchRunning() {
 		return nil, errors.New("dispatch not running")
 	}
@@ -0,0 +1,168 @@
+package bittrex
+
+import (
+	"sync"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+/*
+the subscription channels hand each payload to exactly one reader, so the features built on top of the
+socket (order groups, trailing stops, trackers...) register listeners here instead.  listeners are called
+from the pipe goroutine and must not block; anything slow belongs on the listener's own goroutine.
+*/
+type socketListeners struct {
+	mutex  sync.RWMutex
+	nextID int
+
+	order    map[int]func(socketPayloads.OrderResponse)
+	balance  map[int]func(socketPayloads.Balance)
+	summary  map[int]func(socketPayloads.Summary)
+	exchange map[int]func(socketPayloads.ExchangeDelta)
+}
+
+func (l *socketListeners) add(register func(id int)) func() {
+	l.mutex.Lock()
+	l.nextID++
+	id := l.nextID
+	register(id)
+	l.mutex.Unlock()
+
+	return func() {
+		l.mutex.Lock()
+		delete(l.order, id)
+		delete(l.balance, id)
+		delete(l.summary, id)
+		delete(l.exchange, id)
+		l.mutex.Unlock()
+	}
+}
+
+//addOrderListener call fn for every order delta.  the returned func removes the listener.
+func (c *Client) addOrderListener(fn func(socketPayloads.OrderResponse)) func() {
+	return c.listeners.add(func(id int) {
+		if c.listeners.order == nil {
+			c.listeners.order = make(map[int]func(socketPayloads.OrderResponse))
+		}
+		c.listeners.order[id] = fn
+	})
+}
+
+//notifyOrderListeners returns the number of listeners called.
+func (c *Client) notifyOrderListeners(order socketPayloads.OrderResponse) int {
+	c.listeners.mutex.RLock()
+	defer c.listeners.mutex.RUnlock()
+
+	for _, fn := range c.listeners.order {
+		fn(order)
+	}
+
+	return len(c.listeners.order)
+}
+
+//addBalanceListener call fn for every balance delta, nonce included.  the returned func removes the listener.
+func (c *Client) addBalanceListener(fn func(socketPayloads.Balance)) func() {
+	return c.listeners.add(func(id int) {
+		if c.listeners.balance == nil {
+			c.listeners.balance = make(map[int]func(socketPayloads.Balance))
+		}
+		c.listeners.balance[id] = fn
+	})
+}
+
+//notifyBalanceListeners returns the number of listeners called.
+func (c *Client) notifyBalanceListeners(balance socketPayloads.Balance) int {
+	c.listeners.mutex.RLock()
+	defer c.listeners.mutex.RUnlock()
+
+	for _, fn := range c.listeners.balance {
+		fn(balance)
+	}
+
+	return len(c.listeners.balance)
+}
+
+/*
+addSummaryListener call fn for every market summary delta, for all markets.
+the summary delta feed is subscribed to if it wasn't already.
+*/
+func (c *Client) addSummaryListener(fn func(socketPayloads.Summary)) (func(), error) {
+	if subscribeErr := c.subscribeToSummaryDeltas(); subscribeErr != nil {
+		return nil, subscribeErr
+	}
+
+	return c.listeners.add(func(id int) {
+		if c.listeners.summary == nil {
+			c.listeners.summary = make(map[int]func(socketPayloads.Summary))
+		}
+		c.listeners.summary[id] = fn
+	}), nil
+}
+
+//notifySummaryListeners returns the number of listeners called.
+func (c *Client) notifySummaryListeners(summary socketPayloads.Summary) int {
+	c.listeners.mutex.RLock()
+	defer c.listeners.mutex.RUnlock()
+
+	for _, fn := range c.listeners.summary {
+		fn(summary)
+	}
+
+	return len(c.listeners.summary)
+}
+
+/*
+addExchangeListener call fn for every exchange delta of market.
+the market's exchange deltas are subscribed to if they weren't already.
+*/
+func (c *Client) addExchangeListener(market string, fn func(socketPayloads.ExchangeDelta)) (func(), error) {
+	if subscribeErr := c.subscribeToExchangeDeltas(market); subscribeErr != nil {
+		return nil, subscribeErr
+	}
+
+	return c.listeners.add(func(id int) {
+		if c.listeners.exchange == nil {
+			c.listeners.exchange = make(map[int]func(socketPayloads.ExchangeDelta))
+		}
+		c.listeners.exchange[id] = func(delta socketPayloads.ExchangeDelta) {
+			if delta.MarketName == market {
+				fn(delta)
+			}
+		}
+	}), nil
+}
+
+//notifyExchangeListeners returns the number of listeners called.
+func (c *Client) notifyExchangeListeners(delta socketPayloads.ExchangeDelta) int {
+	c.listeners.mutex.RLock()
+	defer c.listeners.mutex.RUnlock()
+
+	for _, fn := range c.listeners.exchange {
+		fn(delta)
+	}
+
+	return len(c.listeners.exchange)
+}
+
+/*
+OnOrderDelta call fn for every order delta.  Unlike SubscribeToOrderChanges, any number of callers can listen.  fn is
+called from the socket's goroutine and must not block.  The returned func removes it.
+*/
+func (c *Client) OnOrderDelta(fn func(socketPayloads.OrderResponse)) func() {
+	return c.addOrderListener(fn)
+}
+
+//OnBalanceDelta call fn for every balance delta.  fn must not block.  The returned func removes it.
+func (c *Client) OnBalanceDelta(fn func(socketPayloads.Balance)) func() {
+	return c.addBalanceListener(fn)
+}
+
+//OnSummaryDelta call fn for every market summary delta, subscribing to the feed if needed.  fn must not block.
+func (c *Client) OnSummaryDelta(fn func(socketPayloads.Summary)) (func(), error) {
+	return c.addSummaryListener(fn)
+}
+
+//OnExchangeDelta call fn for every exchange delta of market, subscribing to the market if needed.  fn must not block.
+func (c *Client) OnExchangeDelta(market string, fn func(socketPayloads.ExchangeDelta)) (func(), error) {
+	return c.addExchangeListener(market, fn)
+}
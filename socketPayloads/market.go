@@ -0,0 +1,28 @@
+package socketPayloads
+
+import "github.com/technicalviking/bittrex2/market"
+
+//Market typed form of MarketName.
+func (s Summary) Market() (market.Market, error) {
+	return market.Parse(s.MarketName)
+}
+
+//Market typed form of MarketName.
+func (s SummaryLiteDelta) Market() (market.Market, error) {
+	return market.Parse(s.MarketName)
+}
+
+//Market typed form of MarketName.
+func (e ExchangeDelta) Market() (market.Market, error) {
+	return market.Parse(e.MarketName)
+}
+
+//Market typed form of MarketName.
+func (e ExchangeState) Market() (market.Market, error) {
+	return market.Parse(e.MarketName)
+}
+
+//Market typed form of Exchange.
+func (o Order) Market() (market.Market, error) {
+	return market.Parse(o.Exchange)
+}
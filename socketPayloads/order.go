@@ -24,6 +24,14 @@ type Order struct {
 	Updated           date    `json:"u"`
 }
 
+//Order delta types found in OrderResponse.Type
+const (
+	OrderDeltaOpen = iota
+	OrderDeltaPartial
+	OrderDeltaFill
+	OrderDeltaCancel
+)
+
 //OrderResponse Payload response for Order Delta (uO)
 type OrderResponse struct {
 	AccountUUID guid  `json:"w"`
@@ -32,6 +32,16 @@ func (d *date) UnmarshalJSON(raw []byte) error {
 	return nil
 }
 
+//MarshalJSON milliseconds since the epoch, as UnmarshalJSON reads them.
+func (d date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(d).UnixNano() / int64(time.Millisecond))
+}
+
 func (d *date) Get() time.Time {
 	return time.Time(*d)
 }
+
+//Set for building payloads outside this package, such as from another transport.
+func (d *date) Set(t time.Time) {
+	*d = date(t)
+}
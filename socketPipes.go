@@ -2,21 +2,35 @@ package bittrex
 
 import (
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/technicalviking/bittrex2/socketPayloads"
 )
 
+//socketDecodeError a delta that could not be decoded goes to the error channel rather than taking the client down.
+func (c *Client) socketDecodeError(event string, parseErr error) {
+	c.attachedMetrics().decodeError(event)
+	c.socketOnErrorMethod(fmt.Errorf("%s - decode delta: %s", event, parseErr.Error()))
+}
+
 func (c *Client) pipeEventOrderDelta(args json.RawMessage) {
 	var order socketPayloads.OrderResponse
 	parseErr := json.Unmarshal(args, &order)
 
 	if parseErr != nil {
-		//@todo pipe this to the client's error chan, when it exists.
-		panic(parseErr)
+		c.socketDecodeError(eventOrderDelta, parseErr)
+		return
 	}
 
+	listened := c.notifyOrderListeners(order)
+
 	if c.orderSubscription != nil {
+		started := time.Now()
 		c.orderSubscription <- order
+		c.attachedMetrics().delivered("orders", order.Order.Exchange, started)
+	} else if listened == 0 {
+		c.attachedMetrics().unroutedDelta("orders")
 	}
 }
 
@@ -25,12 +39,18 @@ func (c *Client) pipeBalanceDelta(args json.RawMessage) {
 	parseErr := json.Unmarshal(args, &balance)
 
 	if parseErr != nil {
-		//@todo pipe this to the client's error chan, when it exists.
-		panic(parseErr)
+		c.socketDecodeError(eventBalanceDelta, parseErr)
+		return
 	}
 
+	listened := c.notifyBalanceListeners(balance)
+
 	if c.balanceSubscription != nil {
+		started := time.Now()
 		c.balanceSubscription <- balance.BalanceDelta
+		c.attachedMetrics().delivered("balances", balance.BalanceDelta.Currency, started)
+	} else if listened == 0 {
+		c.attachedMetrics().unroutedDelta("balances")
 	}
 }
 
@@ -39,15 +59,21 @@ func (c *Client) pipeMarketExchangeDelta(args json.RawMessage) {
 	parseErr := json.Unmarshal(args, &exchangeDelta)
 
 	if parseErr != nil {
-		//@todo pipe this to the client's error chan, when it exists.
-		panic(parseErr)
+		c.socketDecodeError(eventMarketDelta, parseErr)
+		return
 	}
 
+	listened := c.notifyExchangeListeners(exchangeDelta)
+
 	c.exchangeDeltaMutex.Lock()
 	defer c.exchangeDeltaMutex.Unlock()
 
 	if _, ok := c.exchangeDeltaSubscriptions[exchangeDelta.MarketName]; ok {
+		started := time.Now()
 		c.exchangeDeltaSubscriptions[exchangeDelta.MarketName] <- exchangeDelta
+		c.attachedMetrics().delivered("exchange", exchangeDelta.MarketName, started)
+	} else if listened == 0 {
+		c.attachedMetrics().unroutedDelta("exchange")
 	}
 }
 
@@ -56,8 +82,13 @@ func (c *Client) pipeEventSummaryDelta(args json.RawMessage) {
 	parseErr := json.Unmarshal(args, &summary)
 
 	if parseErr != nil {
-		//@todo pipe this to the client's error chan, when it exists.
-		panic(parseErr)
+		c.socketDecodeError(eventSummaryDelta, parseErr)
+		return
+	}
+
+	listened := 0
+	for _, curDelta := range summary.Deltas {
+		listened = c.notifySummaryListeners(curDelta)
 	}
 
 	c.summaryDeltaMutex.Lock()
@@ -65,7 +96,11 @@ func (c *Client) pipeEventSummaryDelta(args json.RawMessage) {
 
 	for _, curDelta := range summary.Deltas {
 		if _, ok := c.summaryDeltaSubscriptions[curDelta.MarketName]; ok {
+			started := time.Now()
 			c.summaryDeltaSubscriptions[curDelta.MarketName] <- curDelta
+			c.attachedMetrics().delivered("summary", curDelta.MarketName, started)
+		} else if listened == 0 {
+			c.attachedMetrics().unroutedDelta("summary")
 		}
 	}
 }
@@ -75,8 +110,8 @@ func (c *Client) pipeEventSummaryDeltaLite(args json.RawMessage) {
 	parseErr := json.Unmarshal(args, &summary)
 
 	if parseErr != nil {
-		//@todo pipe this to the client's error chan, when it exists.
-		panic(parseErr)
+		c.socketDecodeError(eventSummaryDeltaLite, parseErr)
+		return
 	}
 
 	c.summaryLiteDeltaMutex.Lock()
@@ -84,7 +119,11 @@ func (c *Client) pipeEventSummaryDeltaLite(args json.RawMessage) {
 
 	for _, curDelta := range summary.Deltas {
 		if _, ok := c.summaryLiteDeltaSubscriptions[curDelta.MarketName]; ok {
+			started := time.Now()
 			c.summaryLiteDeltaSubscriptions[curDelta.MarketName] <- curDelta
+			c.attachedMetrics().delivered("summary_lite", curDelta.MarketName, started)
+		} else {
+			c.attachedMetrics().unroutedDelta("summary_lite")
 		}
 	}
 }
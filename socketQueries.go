@@ -2,13 +2,19 @@ package bittrex
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/technicalviking/bittrex2/socketPayloads"
 )
 
 //QueryExchangeState https://github.com/Bittrex/beta#queryexchangestate
 func (c *Client) QueryExchangeState(market string) (*socketPayloads.ExchangeState, error) {
-	resp, err := c.socketClient.CallHub(websocketHub, "QueryExchangeState", market)
+	socket := c.socket()
+	if socket == nil {
+		return nil, fmt.Errorf("QueryExchangeState - the websocket is not connected")
+	}
+
+	resp, err := socket.CallHub(websocketHub, "QueryExchangeState", market)
 
 	if err != nil {
 		return nil, err
@@ -31,7 +37,12 @@ func (c *Client) QueryExchangeState(market string) (*socketPayloads.ExchangeStat
 
 //QuerySummaryState https://github.com/Bittrex/beta#querysummarystate
 func (c *Client) QuerySummaryState() (*socketPayloads.SummaryQueryResponse, error) {
-	resp, err := c.socketClient.CallHub(websocketHub, "QuerySummaryState")
+	socket := c.socket()
+	if socket == nil {
+		return nil, fmt.Errorf("QuerySummaryState - the websocket is not connected")
+	}
+
+	resp, err := socket.CallHub(websocketHub, "QuerySummaryState")
 
 	if err != nil {
 		return nil, err
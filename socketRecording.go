@@ -0,0 +1,27 @@
+package bittrex
+
+import "github.com/technicalviking/bittrex2/signalr"
+
+/*
+RecordWebSocket write every inbound socket frame to the given recorder.
+The recorder survives calls to ConnectWebSocket.  Pass nil to stop recording.
+*/
+func (c *Client) RecordWebSocket(recorder *signalr.Recorder) {
+	c.attachMutex.Lock()
+	defer c.attachMutex.Unlock()
+
+	c.socketRecorder = recorder
+
+	if c.socketClient != nil {
+		c.socketClient.SetRecorder(recorder)
+	}
+}
+
+/*
+ReplayWebSocket feed previously recorded frames through the same decoding and piping used for live data.
+Subscribe to the channels of interest before calling this; it blocks until the replay is finished or stopped.
+No socket connection is required.
+*/
+func (c *Client) ReplayWebSocket(replay *signalr.Replay) error {
+	return replay.Play(c.socketOnClientMethod)
+}
@@ -0,0 +1,81 @@
+package bittrex_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/technicalviking/bittrex2"
+	"github.com/technicalviking/bittrex2/signalr"
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//encodeFrame deflate and base64 encode payload, the way bittrex sends socket arguments.
+func encodeFrame(t *testing.T, payload string) json.RawMessage {
+	var deflated bytes.Buffer
+
+	writer, _ := flate.NewWriter(&deflated, flate.BestCompression)
+	writer.Write([]byte(payload))
+	writer.Close()
+
+	encoded, marshalErr := json.Marshal(base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if marshalErr != nil {
+		t.Fatal(marshalErr)
+	}
+
+	return encoded
+}
+
+func TestRecordedFramesReplayThroughTheClient(t *testing.T) {
+	dir := t.TempDir()
+
+	recorder, recorderErr := signalr.NewRecorder(dir, 0, 0)
+	if recorderErr != nil {
+		t.Fatal(recorderErr)
+	}
+
+	for _, payload := range []string{
+		`{"w":"account","N":1,"TY":0,"o":{"OU":"first","E":"BTC-LTC","OT":"LIMIT_BUY","Q":2,"q":2,"X":0.0161}}`,
+		`{"w":"account","N":2,"TY":2,"o":{"OU":"first","E":"BTC-LTC","OT":"LIMIT_BUY","Q":2,"q":0,"X":0.0161,"PU":0.016}}`,
+	} {
+		if recordErr := recorder.Record("c2", "uO", []json.RawMessage{encodeFrame(t, payload)}); recordErr != nil {
+			t.Fatal(recordErr)
+		}
+	}
+
+	if closeErr := recorder.Close(); closeErr != nil {
+		t.Fatal(closeErr)
+	}
+
+	replay, replayErr := signalr.NewReplayFromDir(dir)
+	if replayErr != nil {
+		t.Fatal(replayErr)
+	}
+	replay.Speed = 0
+
+	client, _ := bittrex.New("key", "secret")
+
+	var received []socketPayloads.OrderResponse
+	remove := client.OnOrderDelta(func(order socketPayloads.OrderResponse) {
+		received = append(received, order)
+	})
+	defer remove()
+
+	if playErr := client.ReplayWebSocket(replay); playErr != nil {
+		t.Fatal(playErr)
+	}
+
+	if len(received) != 2 {
+		t.Fatalf("expected both recorded deltas, got %d", len(received))
+	}
+
+	if received[0].Type != socketPayloads.OrderDeltaOpen || received[0].Order.OrderUUID != "first" || received[0].Order.Quantity != 2 {
+		t.Errorf("unexpected first delta %+v", received[0])
+	}
+
+	if received[1].Type != socketPayloads.OrderDeltaFill || received[1].Nonce != 2 || received[1].Order.PricePerUnit != 0.016 {
+		t.Errorf("unexpected second delta %+v", received[1])
+	}
+}
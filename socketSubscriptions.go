@@ -8,12 +8,8 @@ import (
 
 //SubscribeToMarketSummary retrieve a filtered list of market summary deltas by market name.
 func (c *Client) SubscribeToMarketSummary(market string) (chan socketPayloads.Summary, error) {
-	if !c.isSubbedToSummaryDelta {
-		if _, callErr := c.socketClient.CallHub(websocketHub, "SubscribeToSummaryDeltas"); callErr != nil {
-			return nil, callErr
-		}
-
-		c.isSubbedToSummaryDelta = true
+	if subscribeErr := c.subscribeToSummaryDeltas(); subscribeErr != nil {
+		return nil, subscribeErr
 	}
 
 	if ch := c.getSummaryDeltaChan(market); ch != nil {
@@ -25,6 +21,28 @@ func (c *Client) SubscribeToMarketSummary(market string) (chan socketPayloads.Su
 	return newChan, nil
 }
 
+func (c *Client) subscribeToSummaryDeltas() error {
+	c.summarySubscribeMutex.Lock()
+	defer c.summarySubscribeMutex.Unlock()
+
+	if c.isSubbedToSummaryDelta {
+		return nil
+	}
+
+	socket := c.socket()
+	if socket == nil {
+		return fmt.Errorf("subscribeToSummaryDeltas - the websocket is not connected")
+	}
+
+	if _, callErr := socket.CallHub(websocketHub, "SubscribeToSummaryDeltas"); callErr != nil {
+		return callErr
+	}
+
+	c.isSubbedToSummaryDelta = true
+
+	return nil
+}
+
 func (c *Client) getSummaryDeltaChan(market string) chan socketPayloads.Summary {
 	c.summaryDeltaMutex.RLock()
 	defer c.summaryDeltaMutex.RUnlock()
@@ -86,18 +104,44 @@ func (c *Client) SubscribeToExchange(market string) (chan socketPayloads.Exchang
 		return ch, nil
 	}
 
-	resp, callErr := c.socketClient.CallHub(websocketHub, "SubscribeToExchangeDeltas", market)
+	if subscribeErr := c.subscribeToExchangeDeltas(market); subscribeErr != nil {
+		return nil, subscribeErr
+	}
+
+	newChan := make(chan socketPayloads.ExchangeDelta)
+	c.setExchangeDeltaChan(market, newChan)
+	return newChan, nil
+}
+
+func (c *Client) subscribeToExchangeDeltas(market string) error {
+	c.exchangeSubscribedMutex.Lock()
+	defer c.exchangeSubscribedMutex.Unlock()
+
+	if c.exchangeSubscribed[market] {
+		return nil
+	}
+
+	socket := c.socket()
+	if socket == nil {
+		return fmt.Errorf("subscribeToExchangeDeltas - the websocket is not connected")
+	}
+
+	resp, callErr := socket.CallHub(websocketHub, "SubscribeToExchangeDeltas", market)
 	if callErr != nil {
-		return nil, callErr
+		return callErr
 	}
 
 	if string(resp) != "true" {
-		return nil, fmt.Errorf("unsuccessful subscription to %s", market)
+		return fmt.Errorf("unsuccessful subscription to %s", market)
 	}
 
-	newChan := make(chan socketPayloads.ExchangeDelta)
-	c.setExchangeDeltaChan(market, newChan)
-	return newChan, nil
+	if c.exchangeSubscribed == nil {
+		c.exchangeSubscribed = make(map[string]bool)
+	}
+
+	c.exchangeSubscribed[market] = true
+
+	return nil
 }
 
 func (c *Client) getExchangeDeltaChan(market string) chan socketPayloads.ExchangeDelta {
@@ -0,0 +1,446 @@
+package bittrex
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//trailingStopPlaceAttempts times the exit order is tried before the stop reports TrailingStopFailed.
+const trailingStopPlaceAttempts int = 3
+
+//trailingStopClockSkew allowance for the exchange's clock when looking for an exit order that may have been placed.
+const trailingStopClockSkew time.Duration = time.Minute
+
+//TrailingDistanceType how TrailingStopConfig.Distance is measured.
+type TrailingDistanceType int
+
+//Trailing Distance Types
+const (
+	TrailAbsolute TrailingDistanceType = iota //Distance is a rate, eg 0.0001 BTC
+	TrailPercent                              //Distance is a percentage of the best price seen, eg 2.5
+)
+
+//TrailingPriceSource which socket feed drives the stop.
+type TrailingPriceSource int
+
+//Trailing Price Sources
+const (
+	TrailOnSummary TrailingPriceSource = iota //Last price from the market summary deltas
+	TrailOnFills                              //every fill from the market's exchange deltas
+)
+
+//TrailingStopEventType kind of change reported by a TrailingStop.
+type TrailingStopEventType int
+
+//Trailing Stop Event Types
+const (
+	TrailingStopActivated TrailingStopEventType = iota
+	TrailingStopAdjusted
+	TrailingStopTriggered
+	TrailingStopExecuted
+	TrailingStopFailed
+	TrailingStopRetrying //placing the exit order failed without reaching the exchange, and it will be tried again
+)
+
+//TrailingStopEvent a change to the stop level, or the outcome of the final order.
+type TrailingStopEvent struct {
+	Type    TrailingStopEventType
+	Market  string
+	Price   decimal //the price that caused the event
+	Stop    decimal //the stop level after the event
+	Rate    decimal //limit rate of the exit order, set once triggered
+	OrderID string
+	//Err why placing the exit order failed.  Set on TrailingStopExecuted when the order was accepted but the response
+	//could not be read, in which case OrderID may be empty.
+	Err  error
+	Time time.Time
+}
+
+/*
+TrailingStopConfig arguments for NewTrailingStop.
+A SELL stop protects a long position: it trails below the highest price seen and sells when the price falls to it.
+A BUY stop protects a short position and mirrors that.
+*/
+type TrailingStopConfig struct {
+	Market       string
+	Side         OrderSide
+	Quantity     decimal
+	Distance     decimal
+	DistanceType TrailingDistanceType
+	Source       TrailingPriceSource
+
+	//ActivationPrice the stop only starts trailing once the price reaches this level.  zero activates immediately.
+	ActivationPrice decimal
+
+	//SlippageLimit how far past the stop level the exit limit order may be priced, as a fraction (0.01 = 1%).
+	SlippageLimit decimal
+
+	//UseV2 place the exit with PlaceOrder (the v2.0 api) instead of MarketSellLimit / MarketBuyLimit.
+	UseV2 bool
+
+	//OnEvent optional callback, called for every event in addition to the Events channel.
+	OnEvent func(TrailingStopEvent)
+}
+
+/*
+TrailingStop a stop level maintained client side, ratcheting with the market and firing a limit order when breached.
+The exit order is placed from its own goroutine, so prices keep flowing while it is sent.  When a placement times out
+or its response can't be read, the market's orders are checked for it, and it is only sent again (up to three times in
+all) once it is known not to have been placed.  An order refused by the exchange or a local check is not retried.
+*/
+type TrailingStop struct {
+	client *Client
+	config TrailingStopConfig
+
+	mutex   sync.RWMutex
+	active  bool
+	extreme decimal
+	stop    decimal
+	done    bool
+
+	prices chan decimal
+	events chan TrailingStopEvent
+	quit   chan struct{}
+
+	removeListener func()
+	closeOnce      sync.Once
+}
+
+//NewTrailingStop validate config and start following the market.
+func NewTrailingStop(c *Client, config TrailingStopConfig) (*TrailingStop, error) {
+	if config.Side != OrderSideBuy && config.Side != OrderSideSell {
+		return nil, OrderValidationError{"Side", fmt.Sprintf("unknown side %q", config.Side)}
+	}
+
+	if config.Quantity <= 0 {
+		return nil, OrderValidationError{"Quantity", "must be greater than zero"}
+	}
+
+	if config.Distance <= 0 || (config.DistanceType == TrailPercent && config.Distance >= 100) {
+		return nil, OrderValidationError{"Distance", "must be greater than zero, and below 100 for percentages"}
+	}
+
+	if config.SlippageLimit < 0 || config.SlippageLimit >= 1 {
+		return nil, OrderValidationError{"SlippageLimit", "must be a fraction between 0 and 1"}
+	}
+
+	t := &TrailingStop{
+		client: c,
+		config: config,
+		prices: make(chan decimal, 100),
+		events: make(chan TrailingStopEvent, 100),
+		quit:   make(chan struct{}),
+	}
+
+	var listenErr error
+
+	switch config.Source {
+	case TrailOnFills:
+		t.removeListener, listenErr = c.addExchangeListener(config.Market, func(delta socketPayloads.ExchangeDelta) {
+			for _, fill := range delta.Fills {
+				t.observe(fill.Rate)
+			}
+		})
+	default:
+		t.removeListener, listenErr = c.addSummaryListener(func(summary socketPayloads.Summary) {
+			if summary.MarketName == config.Market {
+				t.observe(summary.Last)
+			}
+		})
+	}
+
+	if listenErr != nil {
+		return nil, listenErr
+	}
+
+	go t.run()
+
+	return t, nil
+}
+
+//Events stop adjustments and the final execution.  Buffered; events are dropped if it fills.
+func (t *TrailingStop) Events() chan TrailingStopEvent {
+	return t.events
+}
+
+//Level the current stop level, and whether the stop has activated.
+func (t *TrailingStop) Level() (decimal, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.stop, t.active
+}
+
+//Cancel stop trailing without placing an order.  Once the stop has triggered, an exit order already sent stands, but a failed one is not tried again.
+func (t *TrailingStop) Cancel() {
+	t.closeOnce.Do(func() {
+		//closed first, so a listener blocked handing over a price is released before removal waits on it.
+		close(t.quit)
+		t.removeListener()
+	})
+}
+
+func (t *TrailingStop) observe(price decimal) {
+	select {
+	case t.prices <- price:
+	case <-t.quit:
+	}
+}
+
+func (t *TrailingStop) run() {
+	for {
+		select {
+		case <-t.quit:
+			return
+		case price := <-t.prices:
+			//once triggered, update ignores prices, so they are drained until the exit order is done.
+			if t.update(price) {
+				go func() {
+					t.execute(price)
+					t.Cancel()
+				}()
+			}
+		}
+	}
+}
+
+//update move the stop for a new price.  returns true when the stop is breached.
+func (t *TrailingStop) update(price decimal) bool {
+	if price <= 0 {
+		return false
+	}
+
+	//events are raised once the lock is released, so OnEvent may call Level.
+	var pending []TrailingStopEvent
+	defer func() {
+		for _, event := range pending {
+			t.emit(event)
+		}
+	}()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.done {
+		return false
+	}
+
+	sell := t.config.Side == OrderSideSell
+
+	if !t.active {
+		activation := t.config.ActivationPrice
+
+		if activation > 0 && ((sell && price < activation) || (!sell && price > activation)) {
+			return false
+		}
+
+		t.active = true
+		t.extreme = price
+		t.stop = t.stopFor(price)
+		pending = append(pending, TrailingStopEvent{Type: TrailingStopActivated, Price: price, Stop: t.stop})
+
+		return false
+	}
+
+	if (sell && price > t.extreme) || (!sell && price < t.extreme) {
+		t.extreme = price
+		newStop := t.stopFor(price)
+
+		if (sell && newStop > t.stop) || (!sell && newStop < t.stop) {
+			t.stop = newStop
+			pending = append(pending, TrailingStopEvent{Type: TrailingStopAdjusted, Price: price, Stop: t.stop})
+		}
+
+		return false
+	}
+
+	if (sell && price <= t.stop) || (!sell && price >= t.stop) {
+		t.done = true
+		return true
+	}
+
+	return false
+}
+
+func (t *TrailingStop) stopFor(extreme decimal) decimal {
+	distance := t.config.Distance
+	if t.config.DistanceType == TrailPercent {
+		distance = extreme * t.config.Distance / 100
+	}
+
+	if t.config.Side == OrderSideSell {
+		return extreme - distance
+	}
+
+	return extreme + distance
+}
+
+func (t *TrailingStop) execute(price decimal) {
+	t.mutex.RLock()
+	stop := t.stop
+	t.mutex.RUnlock()
+
+	rate := stop * (1 - t.config.SlippageLimit)
+	if t.config.Side == OrderSideBuy {
+		rate = stop * (1 + t.config.SlippageLimit)
+	}
+
+	t.emit(TrailingStopEvent{Type: TrailingStopTriggered, Price: price, Stop: stop, Rate: rate})
+
+	started := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		orderID, placeErr := t.place(rate)
+
+		switch {
+		case placeErr == nil:
+			t.emit(TrailingStopEvent{Type: TrailingStopExecuted, Price: price, Stop: stop, Rate: rate, OrderID: orderID})
+			return
+		case isOrderAccepted(placeErr):
+			t.emit(TrailingStopEvent{Type: TrailingStopExecuted, Price: price, Stop: stop, Rate: rate, OrderID: orderID, Err: placeErr})
+			return
+		case placeRefused(placeErr):
+			t.emit(TrailingStopEvent{Type: TrailingStopFailed, Price: price, Stop: stop, Rate: rate, Err: placeErr})
+			return
+		}
+
+		//a timeout or unreadable response doesn't show whether the exchange took the order, so it is looked for
+		//before another is sent.  the wait gives a late order time to show up.
+		select {
+		case <-t.quit:
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+
+		placedID, found, lookupErr := t.findPlaced(rate, started)
+
+		switch {
+		case lookupErr != nil:
+			t.emit(TrailingStopEvent{Type: TrailingStopFailed, Price: price, Stop: stop, Rate: rate, Err: fmt.Errorf("trailing stop - %s, and the order could not be looked up: %s", placeErr.Error(), lookupErr.Error())})
+			return
+		case found:
+			t.emit(TrailingStopEvent{Type: TrailingStopExecuted, Price: price, Stop: stop, Rate: rate, OrderID: placedID, Err: placeErr})
+			return
+		case attempt >= trailingStopPlaceAttempts:
+			t.emit(TrailingStopEvent{Type: TrailingStopFailed, Price: price, Stop: stop, Rate: rate, Err: placeErr})
+			return
+		}
+
+		select {
+		case <-t.quit:
+			t.emit(TrailingStopEvent{Type: TrailingStopFailed, Price: price, Stop: stop, Rate: rate, Err: fmt.Errorf("trailing stop - cancelled while retrying: %s", placeErr.Error())})
+			return
+		default:
+		}
+
+		t.emit(TrailingStopEvent{Type: TrailingStopRetrying, Price: price, Stop: stop, Rate: rate, Err: placeErr})
+	}
+}
+
+//place send the exit order once.
+func (t *TrailingStop) place(rate decimal) (string, error) {
+	var orderID string
+	var placeErr error
+
+	switch {
+	case t.config.UseV2:
+		var placed PlacedOrder
+		placed, placeErr = t.client.PlaceOrder(OrderRequest{
+			Market:   t.config.Market,
+			Side:     t.config.Side,
+			Type:     OrderTypeLimit,
+			Quantity: t.config.Quantity,
+			Rate:     rate,
+		})
+		orderID = placed.OrderID
+	case t.config.Side == OrderSideSell:
+		var tx TransactionID
+		tx, placeErr = t.client.MarketSellLimit(t.config.Market, t.config.Quantity, rate)
+		orderID = tx.UUID
+	default:
+		var tx TransactionID
+		tx, placeErr = t.client.MarketBuyLimit(t.config.Market, t.config.Quantity, rate)
+		orderID = tx.UUID
+	}
+
+	return orderID, placeErr
+}
+
+func isOrderAccepted(err error) bool {
+	_, accepted := err.(OrderAcceptedError)
+	return accepted
+}
+
+//placeRefused true for refusals that would only be repeated: by the exchange, or by a local check.  nothing was placed.
+func placeRefused(err error) bool {
+	switch err.(type) {
+	case APIError, RiskViolationError, SessionTrippedError, OrderValidationError, DustOrderError, MarketValidationError:
+		return true
+	}
+
+	return false
+}
+
+/*
+findPlaced look for an exit order sent since started, in the market's open orders and then its order history.  An order
+matches on side, quantity and limit, after the same normalization the order went through.
+*/
+func (t *TrailingStop) findPlaced(rate decimal, started time.Time) (string, bool, error) {
+	quantity := t.config.Quantity
+	if normalized, normalizeErr := t.client.NormalizeOrder(t.config.Market, t.config.Side, quantity, rate); normalizeErr == nil {
+		quantity, rate = normalized.Quantity, normalized.Rate
+	}
+
+	orderType := "LIMIT_" + string(t.config.Side)
+	since := started.Add(-trailingStopClockSkew)
+
+	matches := func(candidateType string, candidateQuantity, candidateLimit decimal, at time.Time) bool {
+		return strings.EqualFold(candidateType, orderType) &&
+			math.Abs(candidateQuantity-quantity) < 1e-8 &&
+			math.Abs(candidateLimit-rate) < 1e-8 &&
+			!at.Before(since)
+	}
+
+	open, openErr := t.client.MarketGetOpenOrders(t.config.Market)
+	if openErr != nil {
+		return "", false, openErr
+	}
+
+	for _, order := range open {
+		if matches(order.OrderType, order.Quantity, order.Limit, order.Opened.Time()) {
+			return order.OrderUUID, true, nil
+		}
+	}
+
+	//a filled or cancelled order has left the open orders.  its timestamp is when it closed, which is later still.
+	history, historyErr := t.client.AccountGetOrderHistory(t.config.Market)
+	if historyErr != nil {
+		return "", false, historyErr
+	}
+
+	for _, order := range history {
+		if matches(order.OrderType, order.Quantity, order.Limit, order.TimeStamp.Time()) {
+			return order.OrderUUID, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+func (t *TrailingStop) emit(event TrailingStopEvent) {
+	event.Market = t.config.Market
+	event.Time = time.Now()
+
+	if t.config.OnEvent != nil {
+		t.config.OnEvent(event)
+	}
+
+	select {
+	case t.events <- event:
+	default:
+	}
+}
@@ -0,0 +1,331 @@
+package bittrex
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/technicalviking/bittrex2/socketPayloads"
+)
+
+//defaultTransferPollInterval how often the histories are polled when no Interval is given.
+const defaultTransferPollInterval time.Duration = time.Minute
+
+//TransferKind deposit or withdrawal.
+type TransferKind string
+
+//Transfer Kinds
+const (
+	TransferDeposit    TransferKind = "DEPOSIT"
+	TransferWithdrawal TransferKind = "WITHDRAWAL"
+)
+
+//TransferState where a deposit or withdrawal is in its lifecycle.
+type TransferState string
+
+//Transfer States
+const (
+	TransferPending        TransferState = "PENDING"    //deposit seen in the balance, not yet in the history
+	TransferConfirming     TransferState = "CONFIRMING" //deposit gaining confirmations
+	TransferCredited       TransferState = "CREDITED"   //deposit added to the balance
+	TransferRequested      TransferState = "REQUESTED"  //withdrawal awaiting authorization
+	TransferAuthorized     TransferState = "AUTHORIZED" //withdrawal authorized, awaiting payment
+	TransferBroadcast      TransferState = "BROADCAST"  //withdrawal has a transaction id, payment still pending
+	TransferCompleted      TransferState = "COMPLETED"
+	TransferCancelled      TransferState = "CANCELLED"
+	TransferInvalidAddress TransferState = "INVALID_ADDRESS"
+)
+
+//TransferEvent a deposit or withdrawal changed state.  Only the new state is reported; a transfer can skip states between polls.
+type TransferEvent struct {
+	Kind          TransferKind
+	State         TransferState
+	PreviousState TransferState
+	Currency      string
+	Amount        decimal
+	Transfer      TransactionHistoryDescription
+	Time          time.Time
+}
+
+//Transfer the latest known state of a deposit or withdrawal.
+type Transfer struct {
+	Kind     TransferKind
+	State    TransferState
+	History  TransactionHistoryDescription
+	LastSeen time.Time
+}
+
+//TransferWatcherConfig arguments for NewTransferWatcher.
+type TransferWatcherConfig struct {
+	//Currency only watch this currency.  empty watches all of them.
+	Currency string
+
+	//Interval between polls.  balance deltas showing a pending amount trigger an extra poll.  defaults to a minute.
+	Interval time.Duration
+
+	//MinConfirmations deposits with fewer confirmations are reported as CONFIRMING rather than CREDITED.
+	MinConfirmations int
+
+	//OnEvent optional callback, called for every event in addition to the Events channel.
+	OnEvent func(TransferEvent)
+}
+
+/*
+TransferWatcher follows deposits and withdrawals by polling the deposit and withdrawal histories, keyed by
+PaymentUUID (deposits by Id or TxID).  Transfers already in the history when the watcher starts are loaded without
+events.
+*/
+type TransferWatcher struct {
+	client *Client
+	config TransferWatcherConfig
+
+	mutex     sync.RWMutex
+	transfers map[string]*Transfer
+	pending   map[string]decimal //currency -> pending balance from the socket
+
+	events  chan TransferEvent
+	errChan chan error
+	hints   chan TransferEvent
+	poke    chan struct{}
+	quit    chan struct{}
+
+	removeListener func()
+	closeOnce      sync.Once
+}
+
+//NewTransferWatcher load the current histories and start watching.
+func NewTransferWatcher(c *Client, config TransferWatcherConfig) (*TransferWatcher, error) {
+	if config.Interval <= 0 {
+		config.Interval = defaultTransferPollInterval
+	}
+
+	w := &TransferWatcher{
+		client:    c,
+		config:    config,
+		transfers: make(map[string]*Transfer),
+		pending:   make(map[string]decimal),
+		events:    make(chan TransferEvent, 100),
+		errChan:   make(chan error, 5),
+		hints:     make(chan TransferEvent, 100),
+		poke:      make(chan struct{}, 1),
+		quit:      make(chan struct{}),
+	}
+
+	if _, pollErr := w.poll(false); pollErr != nil {
+		return nil, pollErr
+	}
+
+	w.removeListener = c.addBalanceListener(w.balanceDelta)
+
+	go w.run()
+
+	return w, nil
+}
+
+//Events state changes.  Buffered; events are dropped if it fills.
+func (w *TransferWatcher) Events() chan TransferEvent {
+	return w.events
+}
+
+//Errors failed polls.  Buffered; errors are dropped if it fills.
+func (w *TransferWatcher) Errors() chan error {
+	return w.errChan
+}
+
+//Transfers every deposit and withdrawal seen so far.
+func (w *TransferWatcher) Transfers() []Transfer {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	result := make([]Transfer, 0, len(w.transfers))
+	for _, transfer := range w.transfers {
+		result = append(result, *transfer)
+	}
+
+	return result
+}
+
+//Poll check the histories now, without waiting for the next interval.
+func (w *TransferWatcher) Poll() error {
+	events, pollErr := w.poll(true)
+
+	for _, event := range events {
+		w.emit(event)
+	}
+
+	return pollErr
+}
+
+//Close stop watching.
+func (w *TransferWatcher) Close() {
+	w.closeOnce.Do(func() {
+		close(w.quit)
+		w.removeListener()
+	})
+}
+
+func (w *TransferWatcher) run() {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+		case <-w.poke:
+		case hint := <-w.hints:
+			w.emit(hint)
+		}
+
+		if pollErr := w.Poll(); pollErr != nil {
+			select {
+			case w.errChan <- pollErr:
+			default:
+			}
+		}
+	}
+}
+
+//balanceDelta a change in a pending balance means a deposit arrived or moved on, so poll early.
+func (w *TransferWatcher) balanceDelta(balance socketPayloads.Balance) {
+	currency := strings.ToUpper(balance.Currency)
+
+	if w.config.Currency != "" && !strings.EqualFold(w.config.Currency, currency) {
+		return
+	}
+
+	w.mutex.Lock()
+	previous := w.pending[currency]
+	w.pending[currency] = balance.Pending
+	w.mutex.Unlock()
+
+	if balance.Pending == previous {
+		return
+	}
+
+	//handed to the worker, which reports it and polls; listeners must not block.
+	if balance.Pending > previous {
+		select {
+		case w.hints <- TransferEvent{Kind: TransferDeposit, State: TransferPending, Currency: currency, Amount: balance.Pending - previous}:
+			return
+		default:
+		}
+	}
+
+	select {
+	case w.poke <- struct{}{}:
+	default:
+	}
+}
+
+//poll fetch both histories and return the state changes.  notify false records states without reporting them.
+func (w *TransferWatcher) poll(notify bool) ([]TransferEvent, error) {
+	deposits, depositErr := w.client.AccountGetDepositHistory(w.config.Currency)
+	if depositErr != nil {
+		return nil, fmt.Errorf("transfer watcher - deposit history: %s", depositErr.Error())
+	}
+
+	withdrawals, withdrawalErr := w.client.AccountGetWithdrawalHistory(w.config.Currency)
+	if withdrawalErr != nil {
+		return nil, fmt.Errorf("transfer watcher - withdrawal history: %s", withdrawalErr.Error())
+	}
+
+	now := time.Now()
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	var events []TransferEvent
+
+	update := func(kind TransferKind, history TransactionHistoryDescription, state TransferState) {
+		key := transferKey(kind, history)
+		if key == "" {
+			return
+		}
+
+		transfer, known := w.transfers[key]
+		if !known {
+			transfer = &Transfer{Kind: kind}
+			w.transfers[key] = transfer
+		}
+
+		previous := transfer.State
+		transfer.State = state
+		transfer.History = history
+		transfer.LastSeen = now
+
+		if notify && previous != state {
+			events = append(events, TransferEvent{
+				Kind:          kind,
+				State:         state,
+				PreviousState: previous,
+				Currency:      history.Currency,
+				Amount:        history.Amount,
+				Transfer:      history,
+			})
+		}
+	}
+
+	for _, deposit := range deposits {
+		update(TransferDeposit, deposit, w.depositState(deposit))
+	}
+
+	for _, withdrawal := range withdrawals {
+		update(TransferWithdrawal, withdrawal, withdrawalTransferState(withdrawal))
+	}
+
+	return events, nil
+}
+
+func transferKey(kind TransferKind, history TransactionHistoryDescription) string {
+	switch {
+	case history.PaymentUUID != "":
+		return string(kind) + ":" + history.PaymentUUID
+	case history.ID != 0:
+		return fmt.Sprintf("%s:%d", kind, history.ID)
+	case history.TxID != "":
+		return string(kind) + ":" + history.Currency + ":" + history.TxID
+	}
+
+	return ""
+}
+
+func (w *TransferWatcher) depositState(deposit TransactionHistoryDescription) TransferState {
+	if deposit.Confirmations < w.config.MinConfirmations {
+		return TransferConfirming
+	}
+
+	return TransferCredited
+}
+
+func withdrawalTransferState(withdrawal TransactionHistoryDescription) TransferState {
+	switch {
+	case withdrawal.Canceled:
+		return TransferCancelled
+	case withdrawal.InvalidAddress:
+		return TransferInvalidAddress
+	case !withdrawal.Authorized:
+		return TransferRequested
+	case withdrawal.TxID != "" && withdrawal.PendingPayment:
+		return TransferBroadcast
+	case withdrawal.PendingPayment:
+		return TransferAuthorized
+	}
+
+	return TransferCompleted
+}
+
+func (w *TransferWatcher) emit(event TransferEvent) {
+	event.Time = time.Now()
+
+	if w.config.OnEvent != nil {
+		w.config.OnEvent(event)
+	}
+
+	select {
+	case w.events <- event:
+	default:
+	}
+}
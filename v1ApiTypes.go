@@ -23,6 +23,12 @@ func (bt *Timestamp) UnmarshalJSON(raw []byte) error {
 		return err
 	}
 
+	//null, as in the Closed field of an open order.
+	if strTimestamp == "" {
+		*bt = Timestamp{}
+		return nil
+	}
+
 	var parseErr error
 	var parsedTime time.Time
 	if parsedTime, parseErr = time.Parse("2006-01-02T15:04:05", strTimestamp); parseErr != nil {
@@ -33,6 +39,16 @@ func (bt *Timestamp) UnmarshalJSON(raw []byte) error {
 	return nil
 }
 
+//MarshalJSON implement json.Marshaler interface, in the format UnmarshalJSON reads.  The zero time is null.
+func (bt Timestamp) MarshalJSON() ([]byte, error) {
+	cast := time.Time(bt)
+	if cast.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(cast.Format("2006-01-02T15:04:05.999999999"))
+}
+
 //String implement stringer interface
 func (bt *Timestamp) String() string {
 	cast := time.Time(*bt)
@@ -199,6 +215,12 @@ type TransactionHistoryDescription struct {
 	TxID           string    `json:"TxId"`           // : null,
 	Canceled       bool      `json:"Canceled"`       // : true,
 	InvalidAddress bool      `json:"InvalidAddress"` // : false
+
+	//deposit history only
+	ID            int       `json:"Id"`            // : 1234567,
+	Confirmations int       `json:"Confirmations"` // : 6,
+	LastUpdated   Timestamp `json:"LastUpdated"`   // : "2014-07-09T04:30:12.5",
+	CryptoAddress string    `json:"CryptoAddress"` // : "1DeaaFBdbB5nrHj87x3NHS4onvw1GPNyAu"
 }
 
 //AccountBalance result element as described under /account/getbalances. also the result body of /account/getbalance
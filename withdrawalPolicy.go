@@ -0,0 +1,287 @@
+package bittrex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+//ErrWithdrawalDryRun returned by AccountWithdraw when the policy is in dry-run mode and the withdrawal passed every check.
+var ErrWithdrawalDryRun = errors.New("withdrawal passed policy checks, not sent (dry run)")
+
+//WithdrawalRule the policy check a withdrawal failed.
+type WithdrawalRule string
+
+//Withdrawal Rules
+const (
+	WithdrawalNotWhitelisted WithdrawalRule = "NOT_WHITELISTED"
+	WithdrawalPaymentID      WithdrawalRule = "PAYMENT_ID"
+	WithdrawalInvalidAddress WithdrawalRule = "INVALID_ADDRESS"
+	WithdrawalDailyLimit     WithdrawalRule = "DAILY_LIMIT"
+	WithdrawalNotApproved    WithdrawalRule = "NOT_APPROVED"
+)
+
+//WithdrawalPolicyError returned by AccountWithdraw when a withdrawal breaks the policy.
+type WithdrawalPolicyError struct {
+	Rule     WithdrawalRule
+	Currency string
+	Address  string
+	Reason   string
+}
+
+func (e WithdrawalPolicyError) Error() string {
+	return fmt.Sprintf("withdrawal policy %s - %s to %s: %s", e.Rule, e.Currency, e.Address, e.Reason)
+}
+
+//WithdrawalRequest the arguments of an AccountWithdraw call, as seen by the policy and the approval hook.
+type WithdrawalRequest struct {
+	Currency  string
+	Quantity  decimal
+	Address   string
+	PaymentID string
+}
+
+//WhitelistedAddress a destination withdrawals may be sent to.
+type WhitelistedAddress struct {
+	Address string
+	//PaymentID when set, withdrawals to this address must use exactly this payment id.
+	PaymentID string
+	Label     string
+}
+
+//WithdrawalPolicyConfig arguments for NewWithdrawalPolicy.
+type WithdrawalPolicyConfig struct {
+	//Whitelist addresses by currency.  When set, only listed addresses of listed currencies may be withdrawn to.
+	Whitelist map[string][]WhitelistedAddress
+
+	//PaymentIDRequired currencies that may not be withdrawn without a payment id (XRP, XMR and other memo based coins).
+	PaymentIDRequired map[string]bool
+
+	//DailyLimit most of a currency that may be withdrawn per UTC day.
+	DailyLimit map[string]decimal
+
+	//ValidateAddresses check address format and checksum for the currencies ValidateAddress knows.
+	ValidateAddresses bool
+	//AddressValidators extra or replacement format checks, by currency.
+	AddressValidators map[string]AddressValidator
+
+	//DryRun run every check, then return ErrWithdrawalDryRun instead of sending the withdrawal.
+	DryRun bool
+
+	//Approve optional second step, called last.  The withdrawal is only signed and sent if it returns nil.
+	Approve func(WithdrawalRequest) error
+
+	//StatePath optional file the daily totals are kept in, so a restart doesn't reset them.
+	StatePath string
+}
+
+type withdrawalState struct {
+	Day       string
+	Withdrawn map[string]decimal
+}
+
+/*
+WithdrawalPolicy guardrails checked by AccountWithdraw once attached with UseWithdrawalPolicy.
+Amounts count against the daily limit from the moment a withdrawal passes the checks, and are released again if it
+is not approved or the api refuses it.
+*/
+type WithdrawalPolicy struct {
+	config WithdrawalPolicyConfig
+
+	mutex sync.Mutex
+	state withdrawalState
+}
+
+//NewWithdrawalPolicy validate config and load the daily totals from StatePath, if set.
+func NewWithdrawalPolicy(config WithdrawalPolicyConfig) (*WithdrawalPolicy, error) {
+	for currency, limit := range config.DailyLimit {
+		if limit < 0 {
+			return nil, fmt.Errorf("NewWithdrawalPolicy - negative daily limit for %s", currency)
+		}
+	}
+
+	p := &WithdrawalPolicy{config: config}
+
+	if config.StatePath != "" {
+		if loadErr := loadJSONFile(config.StatePath, &p.state); loadErr != nil {
+			return nil, loadErr
+		}
+	}
+
+	p.rollDayLocked(time.Now())
+
+	return p, nil
+}
+
+//UseWithdrawalPolicy check every AccountWithdraw call against p.  Pass nil to remove the policy.
+func (c *Client) UseWithdrawalPolicy(p *WithdrawalPolicy) {
+	c.withdrawalPolicy = p
+}
+
+//WithdrawnToday amount of currency counted against today's limit.
+func (p *WithdrawalPolicy) WithdrawnToday(currency string) decimal {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.rollDayLocked(time.Now())
+
+	return p.state.Withdrawn[strings.ToUpper(currency)]
+}
+
+//Check run the whitelist, payment id, address and daily limit checks without reserving anything or asking for approval.
+func (p *WithdrawalPolicy) Check(request WithdrawalRequest) error {
+	if checkErr := p.checkDestination(request); checkErr != nil {
+		return checkErr
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.checkLimitLocked(request)
+}
+
+func (p *WithdrawalPolicy) checkDestination(request WithdrawalRequest) error {
+	currency := strings.ToUpper(request.Currency)
+
+	policyErr := func(rule WithdrawalRule, reason string) error {
+		return WithdrawalPolicyError{Rule: rule, Currency: currency, Address: request.Address, Reason: reason}
+	}
+
+	if p.config.PaymentIDRequired[currency] && request.PaymentID == "" {
+		return policyErr(WithdrawalPaymentID, "a payment id is required for this currency")
+	}
+
+	if p.config.ValidateAddresses {
+		validator, ok := p.config.AddressValidators[currency]
+		if !ok {
+			validator, ok = addressValidators[currency]
+		}
+
+		if ok {
+			if formatErr := validator(request.Address); formatErr != nil {
+				return policyErr(WithdrawalInvalidAddress, formatErr.Error())
+			}
+		}
+	}
+
+	if p.config.Whitelist == nil {
+		return nil
+	}
+
+	for _, entry := range p.config.Whitelist[currency] {
+		if entry.Address != request.Address {
+			continue
+		}
+
+		if entry.PaymentID != "" && entry.PaymentID != request.PaymentID {
+			return policyErr(WithdrawalPaymentID, fmt.Sprintf("payment id does not match the whitelist entry %q", entry.Label))
+		}
+
+		return nil
+	}
+
+	return policyErr(WithdrawalNotWhitelisted, "address is not on the whitelist")
+}
+
+//checkLimitLocked must be called with the mutex held.
+func (p *WithdrawalPolicy) checkLimitLocked(request WithdrawalRequest) error {
+	currency := strings.ToUpper(request.Currency)
+
+	p.rollDayLocked(time.Now())
+
+	limit, ok := p.config.DailyLimit[currency]
+	if !ok {
+		return nil
+	}
+
+	if total := p.state.Withdrawn[currency] + request.Quantity; total > limit {
+		return WithdrawalPolicyError{
+			Rule:     WithdrawalDailyLimit,
+			Currency: currency,
+			Address:  request.Address,
+			Reason:   fmt.Sprintf("%.8f would be withdrawn today, limit is %.8f", total, limit),
+		}
+	}
+
+	return nil
+}
+
+//authorize run every check, reserve the amount and ask for approval.  the returned func releases the reservation.
+func (p *WithdrawalPolicy) authorize(request WithdrawalRequest) (func(), error) {
+	if checkErr := p.checkDestination(request); checkErr != nil {
+		return nil, checkErr
+	}
+
+	currency := strings.ToUpper(request.Currency)
+
+	p.mutex.Lock()
+	if limitErr := p.checkLimitLocked(request); limitErr != nil {
+		p.mutex.Unlock()
+		return nil, limitErr
+	}
+
+	day := p.state.Day
+	p.state.Withdrawn[currency] += request.Quantity
+	saveErr := p.saveLocked()
+	p.mutex.Unlock()
+
+	release := func() {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+
+		if p.state.Day == day {
+			p.state.Withdrawn[currency] -= request.Quantity
+			p.saveLocked()
+		}
+	}
+
+	if saveErr != nil {
+		release()
+		return nil, saveErr
+	}
+
+	if p.config.Approve != nil {
+		if approveErr := p.config.Approve(request); approveErr != nil {
+			release()
+			return nil, WithdrawalPolicyError{Rule: WithdrawalNotApproved, Currency: currency, Address: request.Address, Reason: approveErr.Error()}
+		}
+	}
+
+	if p.config.DryRun {
+		release()
+		return nil, ErrWithdrawalDryRun
+	}
+
+	return release, nil
+}
+
+//rollDayLocked reset the totals when the UTC date changes.  must be called with the mutex held.
+func (p *WithdrawalPolicy) rollDayLocked(now time.Time) {
+	day := now.UTC().Format("2006-01-02")
+
+	if p.state.Day != day || p.state.Withdrawn == nil {
+		p.state = withdrawalState{Day: day, Withdrawn: make(map[string]decimal)}
+	}
+}
+
+func (p *WithdrawalPolicy) saveLocked() error {
+	if p.config.StatePath == "" {
+		return nil
+	}
+
+	return saveJSONFile(p.config.StatePath, p.state)
+}
+
+/*
+authorizeWithdrawal consult the attached policy, if any, before a withdrawal is signed.
+the returned func must be called if the withdrawal is then not sent or refused.
+*/
+func (c *Client) authorizeWithdrawal(request WithdrawalRequest) (func(), error) {
+	if c.withdrawalPolicy == nil {
+		return func() {}, nil
+	}
+
+	return c.withdrawalPolicy.authorize(request)
+}